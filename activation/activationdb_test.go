@@ -75,6 +75,10 @@ func (MockState) LoadState(types.LayerID) error {
 	panic("implement me")
 }
 
+func (MockState) RevertLayers(types.LayerID, types.LayerID, []types.TransactionID) error {
+	panic("implement me")
+}
+
 func (MockState) GetStateRoot() types.Hash32 {
 	panic("implement me")
 }
@@ -87,11 +91,28 @@ func (MockState) GetLayerApplied(types.TransactionID) *types.LayerID {
 	panic("implement me")
 }
 
+func (MockState) GetReceipt(types.TransactionID) (*types.Receipt, error) {
+	panic("implement me")
+}
+
+func (MockState) GetAccountControl(types.Address) *types.AccountControl {
+	panic("implement me")
+}
+
+func (MockState) GetAccountAtLayer(types.Address, types.LayerID) (*types.AccountState, error) {
+	panic("implement me")
+}
+
+func (MockState) GetAccountProof(types.Address, types.LayerID) (*types.AccountProof, error) {
+	panic("implement me")
+}
+
 func (MockState) ApplyTransactions(types.LayerID, []*types.Transaction) (int, error) {
 	return 0, nil
 }
 
-func (MockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) {
+func (MockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) error {
+	return nil
 }
 
 func (MockState) AddressExists(types.Address) bool {
@@ -285,6 +306,41 @@ func TestActivationDb_GetNodeLastAtxId(t *testing.T) {
 	r.Equal(atx2.ShortString(), id.ShortString(), "atx1.ShortString(): %v", atx1.ShortString())
 }
 
+func TestActivationDb_GetCoinbaseAtxIDs(t *testing.T) {
+	r := require.New(t)
+
+	atxdb, _, _ := getAtxDb("t6")
+	id1 := types.NodeID{Key: uuid.New().String()}
+	coinbase1, coinbase2 := types.HexToAddress("aaaa"), types.HexToAddress("bbbb")
+	epoch1 := types.EpochID(2)
+	atx1 := types.NewActivationTx(newChallenge(id1, 0, *types.EmptyATXID, goldenATXID, epoch1.FirstLayer()), coinbase1, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch1, atx1))
+	_, err := atxdb.GetCoinbaseAtxIDs(coinbase1)
+	r.Equal(mesh.ErrIndexDisabled, err)
+
+	atxdb.ExplorerIndex = true
+	epoch2 := types.EpochID(1) + (1 << 8)
+	atx2 := types.NewActivationTx(newChallenge(id1, 1, atx1.ID(), atx1.ID(), epoch2.FirstLayer()), coinbase1, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch2, atx2))
+	epoch3 := epoch2 + 1
+	atx3 := types.NewActivationTx(newChallenge(id1, 2, atx2.ID(), atx2.ID(), epoch3.FirstLayer()), coinbase2, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch3, atx3))
+
+	// only atxs stored with the index enabled are indexed
+	ids, err := atxdb.GetCoinbaseAtxIDs(coinbase1)
+	r.NoError(err)
+	r.Equal([]types.ATXID{atx2.ID()}, ids)
+	ids, err = atxdb.GetCoinbaseAtxIDs(coinbase2)
+	r.NoError(err)
+	r.Equal([]types.ATXID{atx3.ID()}, ids)
+
+	// the atxs stored before the index was enabled are indexed by the backfill
+	r.NoError(atxdb.BuildExplorerIndex())
+	ids, err = atxdb.GetCoinbaseAtxIDs(coinbase1)
+	r.NoError(err)
+	r.Equal([]types.ATXID{atx1.ID(), atx2.ID()}, ids)
+}
+
 func Test_DBSanity(t *testing.T) {
 	types.SetLayersPerEpoch(int32(layersPerEpochBig))
 
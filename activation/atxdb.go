@@ -35,6 +35,16 @@ func getEpochPrefix(epoch types.EpochID) []byte {
 	return []byte(fmt.Sprintf("e_%v_", epoch.ToBytes()))
 }
 
+// the layer is encoded in big endian, so the atxs of a coinbase are iterated in layer order
+func getCoinbaseAtxKey(coinbase types.Address, layer types.LayerID, atxID types.ATXID) []byte {
+	key := append(getCoinbaseAtxPrefix(coinbase), util.Uint64ToBytesBigEndian(uint64(layer))...)
+	return append(key, atxID.Bytes()...)
+}
+
+func getCoinbaseAtxPrefix(coinbase types.Address) []byte {
+	return []byte(fmt.Sprintf("c_%v_", coinbase.Bytes()))
+}
+
 func getAtxHeaderKey(atxID types.ATXID) []byte {
 	return []byte(fmt.Sprintf("h_%v", atxID.Bytes()))
 }
@@ -68,6 +78,9 @@ type DB struct {
 	calcTotalWeightFunc func(targetEpoch types.EpochID, blocks map[types.BlockID]struct{}) (map[string]uint64, error)
 	processAtxMutex     sync.Mutex
 	atxChannels         map[types.ATXID]*atxChan
+	// ExplorerIndex enables the secondary index of ATXs by coinbase. ATXs are indexed when they're stored while it's set,
+	// and BuildExplorerIndex indexes those stored before it was set.
+	ExplorerIndex bool
 }
 
 // NewDB creates a new struct of type DB, this struct will hold the atxs received from all nodes and
@@ -488,6 +501,13 @@ func (db *DB) StoreAtx(ech types.EpochID, atx *types.ActivationTx) error {
 		return err
 	}
 
+	if db.ExplorerIndex {
+		err = db.addAtxToCoinbase(atx)
+		if err != nil {
+			return err
+		}
+	}
+
 	db.log.With().Info("finished storing atx in epoch", atx.ID(), ech)
 	return nil
 }
@@ -594,6 +614,84 @@ func (db *DB) addNodeAtxToEpoch(epoch types.EpochID, nodeID types.NodeID, atx *t
 	return nil
 }
 
+// addAtxToCoinbase inserts activation atx id by coinbase
+func (db *DB) addAtxToCoinbase(atx *types.ActivationTx) error {
+	err := db.atxs.Put(getCoinbaseAtxKey(atx.Coinbase, atx.PubLayerID, atx.ID()), atx.ID().Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to store atx ID for coinbase: %v", err)
+	}
+	return nil
+}
+
+// explorerIndexKey is set while the index by coinbase covers all the stored ATXs
+var explorerIndexKey = []byte("explorer index")
+
+// BuildExplorerIndex indexes the stored ATXs by coinbase if ExplorerIndex is set and the index doesn't cover them yet,
+// e.g. because it was just set on an existing data dir. If ExplorerIndex isn't set, the index is marked as incomplete,
+// since the ATXs stored from now on aren't indexed, so it's built again once it's set.
+func (db *DB) BuildExplorerIndex() error {
+	if !db.ExplorerIndex {
+		return db.atxs.Delete(explorerIndexKey)
+	}
+	if built, err := db.atxs.Has(explorerIndexKey); err != nil || built {
+		return err
+	}
+	var indexed int
+	it := db.atxs.Find([]byte("h_"))
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		id, ok := parseAtxHeaderKey(it.Key())
+		if !ok {
+			continue
+		}
+		var header types.ActivationTxHeader
+		if err := types.BytesToInterface(it.Value(), &header); err != nil {
+			it.Release()
+			return fmt.Errorf("header of atx %v can't be decoded: %v", id.ShortString(), err)
+		}
+		header.SetID(&id)
+		if err := db.addAtxToCoinbase(&types.ActivationTx{InnerActivationTx: &types.InnerActivationTx{ActivationTxHeader: &header}}); err != nil {
+			it.Release()
+			return err
+		}
+		indexed++
+	}
+	err := it.Error()
+	it.Release()
+	if err != nil {
+		return fmt.Errorf("failed to read atxs: %v", err)
+	}
+	db.log.With().Info("built explorer index of stored atxs", log.Int("num_atxs", indexed))
+	return db.atxs.Put(explorerIndexKey, []byte{1})
+}
+
+// GetCoinbaseAtxIDs returns the IDs of the ATXs that set the given coinbase, ordered by publication layer, or
+// mesh.ErrIndexDisabled if ExplorerIndex isn't set.
+func (db *DB) GetCoinbaseAtxIDs(coinbase types.Address) ([]types.ATXID, error) {
+	if !db.ExplorerIndex {
+		return nil, mesh.ErrIndexDisabled
+	}
+	var atxs []types.ATXID
+	atxIterator := db.atxs.Find(getCoinbaseAtxPrefix(coinbase))
+	defer atxIterator.Release()
+	for atxIterator.Next() {
+		if atxIterator.Key() == nil {
+			break
+		}
+		var a types.ATXID
+		if err := types.BytesToInterface(atxIterator.Value(), &a); err != nil {
+			return nil, fmt.Errorf("cannot parse atx from DB: %v", err)
+		}
+		atxs = append(atxs, a)
+	}
+	if err := atxIterator.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read coinbase index: %v", err)
+	}
+	return atxs, nil
+}
+
 // ErrAtxNotFound is a specific error returned when no atx was found in DB
 type ErrAtxNotFound error
 
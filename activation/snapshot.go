@@ -0,0 +1,46 @@
+package activation
+
+import (
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+)
+
+// ExportAtxs calls fn with every stored ATX, including its NIPST.
+func (db *DB) ExportAtxs(fn func(atx *types.ActivationTx) error) error {
+	it := db.atxs.Find([]byte("h_"))
+	defer it.Release()
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		id, ok := parseAtxHeaderKey(it.Key())
+		if !ok {
+			return fmt.Errorf("unexpected atx header key %q", it.Key())
+		}
+		atx, err := db.GetFullAtx(id)
+		if err != nil {
+			return fmt.Errorf("failed to read atx %v: %v", id.ShortString(), err)
+		}
+		if err := fn(atx); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("failed to read atxs: %v", err)
+	}
+	return nil
+}
+
+// ImportAtx stores an ATX read from a snapshot, and the identity of the node that published it. The ATX's ID is
+// calculated from its content.
+func (db *DB) ImportAtx(atx *types.ActivationTx) error {
+	atx.CalcAndSetID()
+	if err := db.StoreAtx(atx.PubLayerID.GetEpoch(), atx); err != nil {
+		return fmt.Errorf("cannot store atx %s: %v", atx.ShortString(), err)
+	}
+	if err := db.StoreNodeIdentity(atx.NodeID); err != nil {
+		return fmt.Errorf("cannot store identity of atx %s: %v", atx.ShortString(), err)
+	}
+	return nil
+}
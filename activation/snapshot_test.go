@@ -0,0 +1,57 @@
+package activation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+)
+
+func TestActivationDb_ExportImportAtxs(t *testing.T) {
+	r := require.New(t)
+
+	atxdb, _, _ := getAtxDb(t.Name())
+	id := types.NodeID{Key: uuid.New().String(), VRFPublicKey: []byte("vrf")}
+	coinbase := types.HexToAddress("aaaa")
+	epoch := types.EpochID(2)
+	atx1 := types.NewActivationTx(newChallenge(id, 0, *types.EmptyATXID, goldenATXID, epoch.FirstLayer()), coinbase, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch, atx1))
+	atx2 := types.NewActivationTx(newChallenge(id, 1, atx1.ID(), atx1.ID(), (epoch + 1).FirstLayer()), coinbase, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch+1, atx2))
+
+	// the atxs are imported from their encoding, like they're read from a snapshot file
+	var encoded [][]byte
+	r.NoError(atxdb.ExportAtxs(func(atx *types.ActivationTx) error {
+		bytes, err := types.InterfaceToBytes(atx)
+		r.NoError(err)
+		encoded = append(encoded, bytes)
+		return nil
+	}))
+	r.Len(encoded, 2)
+
+	lg := log.NewDefault(t.Name())
+	idStore := NewIdentityStore(database.NewMemDatabase())
+	imported := NewDB(database.NewMemDatabase(), idStore, mesh.NewMemMeshDB(lg.WithName("meshDB")), layersPerEpochBig, goldenATXID, &ValidatorMock{}, lg.WithName("atxDB"))
+	for _, bytes := range encoded {
+		atx, err := types.BytesToAtx(bytes)
+		r.NoError(err)
+		r.NoError(imported.ImportAtx(atx))
+	}
+	for _, atx := range []*types.ActivationTx{atx1, atx2} {
+		got, err := imported.GetFullAtx(atx.ID())
+		r.NoError(err)
+		r.Equal(atx.ID(), got.ID())
+		r.Equal(atx.Coinbase, got.Coinbase)
+	}
+	last, err := imported.GetNodeLastAtxID(id)
+	r.NoError(err)
+	r.Equal(atx2.ID(), last)
+	stored, err := idStore.GetIdentity(id.Key)
+	r.NoError(err)
+	r.Equal(id, stored)
+}
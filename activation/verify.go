@@ -0,0 +1,124 @@
+package activation
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+)
+
+// Verify checks that every stored ATX has a body, that the ATXs it references are stored, and that it's listed in the
+// indexes of ATXs by node and by epoch. All the inconsistencies found are returned, and err is only set if the database
+// couldn't be read. With repair, the missing or wrong index entries are rewritten, as well as the index by coinbase if
+// ExplorerIndex is set.
+func (db *DB) Verify(repair bool) (problems []error, err error) {
+	it := db.atxs.Find([]byte("h_"))
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		id, ok := parseAtxHeaderKey(it.Key())
+		if !ok {
+			problems = append(problems, fmt.Errorf("unexpected atx header key %q", it.Key()))
+			continue
+		}
+		var header types.ActivationTxHeader
+		if err := types.BytesToInterface(it.Value(), &header); err != nil {
+			problems = append(problems, fmt.Errorf("header of atx %v can't be decoded: %v", id.ShortString(), err))
+			continue
+		}
+		header.SetID(&id)
+		problems = append(problems, db.verifyAtx(&header, repair)...)
+	}
+	if err := it.Error(); err != nil {
+		return problems, fmt.Errorf("failed to read atxs: %v", err)
+	}
+	return problems, nil
+}
+
+func (db *DB) verifyAtx(header *types.ActivationTxHeader, repair bool) (problems []error) {
+	id := header.ID()
+	if has, err := db.atxs.Has(getAtxBodyKey(id)); err != nil || !has {
+		problems = append(problems, fmt.Errorf("atx %v has no body", id.ShortString()))
+	}
+	for i, ref := range []types.ATXID{header.PrevATXID, header.PositioningATX} {
+		// the previous ATX is often the positioning ATX too, so it's only reported once
+		if ref == *types.EmptyATXID || ref == db.goldenATXID || (i == 1 && ref == header.PrevATXID) {
+			continue
+		}
+		if has, err := db.atxs.Has(getAtxHeaderKey(ref)); err != nil || !has {
+			problems = append(problems, fmt.Errorf("atx %v references missing atx %v", id.ShortString(), ref.ShortString()))
+		}
+	}
+
+	epoch := header.PubLayerID.GetEpoch()
+	atx := &types.ActivationTx{InnerActivationTx: &types.InnerActivationTx{ActivationTxHeader: header}}
+	if indexed, err := db.atxs.Get(getNodeAtxKey(header.NodeID, epoch)); err != nil || !bytes.Equal(indexed, id.Bytes()) {
+		problems = append(problems, fmt.Errorf("atx %v isn't indexed by its node in epoch %v", id.ShortString(), epoch))
+		if repair {
+			if err := db.addAtxToNodeID(header.NodeID, atx); err != nil {
+				problems = append(problems, err)
+			}
+		}
+	}
+	if indexed, err := db.atxs.Get(getNodeAtxEpochKey(epoch, header.NodeID)); err != nil || !bytes.Equal(indexed, id.Bytes()) {
+		problems = append(problems, fmt.Errorf("atx %v isn't indexed in epoch %v", id.ShortString(), epoch))
+		if repair {
+			if err := db.addNodeAtxToEpoch(epoch, header.NodeID, atx); err != nil {
+				problems = append(problems, err)
+			}
+		}
+	}
+	// ATXs stored before the index was enabled aren't in it, so it's only rebuilt, not checked
+	if repair && db.ExplorerIndex {
+		if err := db.addAtxToCoinbase(atx); err != nil {
+			problems = append(problems, err)
+		}
+	}
+	return problems
+}
+
+// VerifyBlockReferences returns an error if the ATX of the block or one of the ATXs in its active set isn't stored.
+func (db *DB) VerifyBlockReferences(block *types.Block) error {
+	refs := []types.ATXID{block.ATXID}
+	if block.ActiveSet != nil {
+		refs = append(refs, *block.ActiveSet...)
+	}
+	var missing []string
+	for _, ref := range refs {
+		if ref == *types.EmptyATXID || ref == db.goldenATXID {
+			continue
+		}
+		if has, err := db.atxs.Has(getAtxHeaderKey(ref)); err != nil || !has {
+			missing = append(missing, ref.ShortString())
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("references missing atxs %v", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// parseAtxHeaderKey returns the ID of the ATX whose header is stored under the key, which holds the ID's bytes as
+// formatted by getAtxHeaderKey.
+func parseAtxHeaderKey(key []byte) (types.ATXID, bool) {
+	s := strings.TrimPrefix(string(key), "h_")
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return types.ATXID{}, false
+	}
+	var id types.ATXID
+	fields := strings.Fields(s[1 : len(s)-1])
+	if len(fields) != len(id) {
+		return types.ATXID{}, false
+	}
+	for i, f := range fields {
+		b, err := strconv.ParseUint(f, 10, 8)
+		if err != nil {
+			return types.ATXID{}, false
+		}
+		id[i] = byte(b)
+	}
+	return id, true
+}
@@ -0,0 +1,55 @@
+package activation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+)
+
+func TestActivationDb_Verify(t *testing.T) {
+	r := require.New(t)
+
+	atxdb, _, _ := getAtxDb(t.Name())
+	id := types.NodeID{Key: uuid.New().String()}
+	coinbase := types.HexToAddress("aaaa")
+	epoch1 := types.EpochID(2)
+	atx1 := types.NewActivationTx(newChallenge(id, 0, *types.EmptyATXID, goldenATXID, epoch1.FirstLayer()), coinbase, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch1, atx1))
+	epoch2 := epoch1 + 1
+	atx2 := types.NewActivationTx(newChallenge(id, 1, atx1.ID(), atx1.ID(), epoch2.FirstLayer()), coinbase, &types.NIPST{}, 0, nil)
+	r.NoError(atxdb.StoreAtx(epoch2, atx2))
+
+	problems, err := atxdb.Verify(false)
+	r.NoError(err)
+	r.Empty(problems)
+
+	block := types.NewExistingBlock(epoch2.FirstLayer(), []byte("data"), nil)
+	block.ATXID = atx2.ID()
+	block.ActiveSet = &[]types.ATXID{atx1.ID(), atx2.ID()}
+	r.NoError(atxdb.VerifyBlockReferences(block))
+
+	// atx2 is no longer indexed in its epoch, and references a missing atx
+	r.NoError(atxdb.atxs.Delete(getNodeAtxEpochKey(epoch2, id)))
+	r.NoError(atxdb.atxs.Delete(getAtxHeaderKey(atx1.ID())))
+	r.Error(atxdb.VerifyBlockReferences(block))
+
+	problems, err = atxdb.Verify(false)
+	r.NoError(err)
+	r.Len(problems, 2)
+
+	// the missing reference can't be repaired, the index can
+	atxdb.ExplorerIndex = true
+	problems, err = atxdb.Verify(true)
+	r.NoError(err)
+	r.Len(problems, 2)
+	problems, err = atxdb.Verify(false)
+	r.NoError(err)
+	r.Len(problems, 1)
+
+	ids, err := atxdb.GetCoinbaseAtxIDs(coinbase)
+	r.NoError(err)
+	r.Equal([]types.ATXID{atx2.ID()}, ids)
+}
@@ -12,6 +12,11 @@ import (
 type GenesisAccount struct {
 	Balance uint64 `json:"balance"`
 	Nonce   uint64 `json:"nonce"`
+	// Threshold and Owners make the account a multisig account, spent by transactions signed by Threshold of the
+	// Owners (hex addresses). UnlockLayer, if set, also makes it a vault that can't be spent before that layer.
+	Threshold   uint64   `json:"threshold,omitempty"`
+	Owners      []string `json:"owners,omitempty"`
+	UnlockLayer uint64   `json:"unlock_layer,omitempty"`
 }
 
 // GenesisConfig defines accounts that will exist in state at genesis
@@ -4,6 +4,7 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
 	"github.com/spacemeshos/go-spacemesh/api"
+	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/common/util"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"golang.org/x/net/context"
@@ -11,9 +12,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// DebugService exposes global state data, output from the STF
+// DebugService exposes global state data, output from the STF, and the tortoise's view of layers
 type DebugService struct {
-	Mesh api.TxAPI
+	Mesh     api.TxAPI
+	Tortoise api.TortoiseAPI
 }
 
 // RegisterService registers this service with a grpc server instance
@@ -22,9 +24,10 @@ func (d DebugService) RegisterService(server *Server) {
 }
 
 // NewDebugService creates a new grpc service using config data.
-func NewDebugService(tx api.TxAPI) *DebugService {
+func NewDebugService(tx api.TxAPI, tortoise api.TortoiseAPI) *DebugService {
 	return &DebugService{
-		Mesh: tx,
+		Mesh:     tx,
+		Tortoise: tortoise,
 	}
 }
 
@@ -56,3 +59,66 @@ func (d DebugService) Accounts(_ context.Context, in *empty.Empty) (*pb.Accounts
 
 	return res, nil
 }
+
+// TortoiseLayer returns the tortoise's view of a layer, to diagnose layers that fail to verify: the opinion of every
+// block of the later layers on each of the layer's blocks, whether the voting blocks are good, the tallies of the good
+// blocks' votes against the verification threshold, and why the layer isn't verified.
+func (d DebugService) TortoiseLayer(_ context.Context, in *pb.TortoiseLayerRequest) (*pb.TortoiseLayerResponse, error) {
+	log.Info("GRPC DebugService.TortoiseLayer")
+
+	if in.Layer == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`Layer` must be provided")
+	}
+	inspection, err := d.Tortoise.InspectLayer(types.LayerID(in.Layer.Number))
+	if err != nil {
+		log.With().Debug("unable to inspect layer", types.LayerID(in.Layer.Number), log.Err(err))
+		return nil, status.Errorf(codes.NotFound, "error inspecting layer: %v", err)
+	}
+	return convertLayerInspection(inspection), nil
+}
+
+func convertTortoiseOpinion(opinion string) pb.TortoiseOpinion {
+	switch opinion {
+	case "support":
+		return pb.TortoiseOpinion_TORTOISE_OPINION_SUPPORT
+	case "against":
+		return pb.TortoiseOpinion_TORTOISE_OPINION_AGAINST
+	case "abstain":
+		return pb.TortoiseOpinion_TORTOISE_OPINION_ABSTAIN
+	default:
+		return pb.TortoiseOpinion_TORTOISE_OPINION_UNSPECIFIED
+	}
+}
+
+func convertLayerInspection(inspection *types.LayerInspection) *pb.TortoiseLayerResponse {
+	res := &pb.TortoiseLayerResponse{
+		Layer:     &pb.LayerNumber{Number: uint32(inspection.Layer)},
+		Last:      &pb.LayerNumber{Number: uint32(inspection.Last)},
+		Verified:  &pb.LayerNumber{Number: uint32(inspection.Verified)},
+		Threshold: inspection.Threshold,
+		Reason:    inspection.Reason,
+	}
+	for _, b := range inspection.Blocks {
+		block := &pb.TortoiseBlock{
+			Id:            b.ID.Bytes(),
+			Good:          b.Good,
+			Weight:        uint64(b.Weight),
+			Input:         convertTortoiseOpinion(b.Input),
+			Support:       uint64(b.Tally.Support),
+			Against:       uint64(b.Tally.Against),
+			GlobalOpinion: convertTortoiseOpinion(b.GlobalOpinion),
+		}
+		for _, v := range b.Votes {
+			block.Votes = append(block.Votes, &pb.TortoiseVote{
+				Voter:   v.Voter.Bytes(),
+				Layer:   &pb.LayerNumber{Number: uint32(v.Layer)},
+				Good:    v.Good,
+				Weight:  uint64(v.Weight),
+				Support: uint64(v.Vote.Support),
+				Against: uint64(v.Vote.Against),
+			})
+		}
+		res.Blocks = append(res.Blocks, block)
+	}
+	return res
+}
@@ -67,11 +67,56 @@ func (s GlobalStateService) getAccount(addr types.Address) (acct *pb.Account, er
 			Counter: counterProjected,
 			Balance: &pb.Amount{Value: balanceProjected},
 		},
+		Control: convertAccountControl(s.Mesh.GetAccountControl(addr)),
 	}, nil
 }
 
-// Account returns current and projected counter and balance for one account
-func (s GlobalStateService) Account(_ context.Context, in *pb.AccountRequest) (*pb.AccountResponse, error) {
+// getAccountAtLayer returns the account as it was after the given layer was applied. Since there is nothing to project
+// for past layers, its projected state is the same as its current state.
+func (s GlobalStateService) getAccountAtLayer(addr types.Address, layer types.LayerID) (*pb.Account, error) {
+	state, err := s.Mesh.GetAccountAtLayer(addr, layer)
+	if err != nil {
+		log.With().Error("unable to fetch account state at layer", layer, log.Err(err))
+		return nil, status.Errorf(codes.NotFound, "no state recorded for layer %d", layer)
+	}
+	var control *types.AccountControl
+	if len(state.Control) > 0 {
+		control = &state.Control[0]
+	}
+	return &pb.Account{
+		AccountId: &pb.AccountId{Address: addr.Bytes()},
+		StateCurrent: &pb.AccountState{
+			Counter: state.Nonce,
+			Balance: &pb.Amount{Value: state.Balance},
+		},
+		StateProjected: &pb.AccountState{
+			Counter: state.Nonce,
+			Balance: &pb.Amount{Value: state.Balance},
+		},
+		Control: convertAccountControl(control),
+	}, nil
+}
+
+// convertAccountControl returns the spending rules of a multisig or vault account, or nil for simple accounts
+func convertAccountControl(control *types.AccountControl) *pb.AccountControl {
+	if control == nil {
+		return nil
+	}
+	owners := make([]*pb.AccountId, 0, len(control.Owners))
+	for _, owner := range control.Owners {
+		owners = append(owners, &pb.AccountId{Address: owner.Bytes()})
+	}
+	return &pb.AccountControl{
+		Threshold:   control.Threshold,
+		Owners:      owners,
+		UnlockLayer: &pb.LayerNumber{Number: uint32(control.UnlockLayer)},
+	}
+}
+
+// Account returns current and projected counter and balance for one account, along with the spending rules of
+// multisig and vault accounts. If the request sets a layer, the account is returned as it was after that layer was
+// applied.
+func (s GlobalStateService) Account(ctx context.Context, in *pb.AccountRequest) (*pb.AccountResponse, error) {
 	log.Info("GRPC GlobalStateService.Account")
 
 	if in.AccountId == nil {
@@ -80,8 +125,13 @@ func (s GlobalStateService) Account(_ context.Context, in *pb.AccountRequest) (*
 
 	// Load data
 	addr := types.BytesToAddress(in.AccountId.Address)
-	acct, err := s.getAccount(addr)
-	if err != nil {
+	var acct *pb.Account
+	var err error
+	if in.Layer != nil {
+		if acct, err = s.getAccountAtLayer(addr, types.LayerID(in.Layer.Number)); err != nil {
+			return nil, err
+		}
+	} else if acct, err = s.getAccount(addr); err != nil {
 		log.With().Error("unable to fetch projected account state", log.Err(err))
 		return nil, status.Errorf(codes.Internal, "error fetching projected account data")
 	}
@@ -96,8 +146,9 @@ func (s GlobalStateService) Account(_ context.Context, in *pb.AccountRequest) (*
 	return &pb.AccountResponse{AccountWrapper: acct}, nil
 }
 
-// AccountDataQuery returns historical account data such as rewards and receipts
-func (s GlobalStateService) AccountDataQuery(_ context.Context, in *pb.AccountDataQueryRequest) (*pb.AccountDataQueryResponse, error) {
+// AccountDataQuery returns historical account data such as rewards and receipts. If the request sets a layer, only
+// rewards up to that layer are returned, and the account as it was after that layer was applied.
+func (s GlobalStateService) AccountDataQuery(ctx context.Context, in *pb.AccountDataQueryRequest) (*pb.AccountDataQueryResponse, error) {
 	log.Info("GRPC GlobalStateService.AccountDataQuery")
 
 	if in.Filter == nil {
@@ -129,6 +180,9 @@ func (s GlobalStateService) AccountDataQuery(_ context.Context, in *pb.AccountDa
 			return nil, status.Errorf(codes.Internal, "error getting rewards data")
 		}
 		for _, r := range dbRewards {
+			if in.Layer != nil && r.Layer > types.LayerID(in.Layer.Number) {
+				continue
+			}
 			res.AccountItem = append(res.AccountItem, &pb.AccountData{Datum: &pb.AccountData_Reward{
 				Reward: &pb.Reward{
 					Layer:       &pb.LayerNumber{Number: uint32(r.Layer)},
@@ -145,8 +199,13 @@ func (s GlobalStateService) AccountDataQuery(_ context.Context, in *pb.AccountDa
 	}
 
 	if filterAccount {
-		acct, err := s.getAccount(addr)
-		if err != nil {
+		var acct *pb.Account
+		var err error
+		if in.Layer != nil {
+			if acct, err = s.getAccountAtLayer(addr, types.LayerID(in.Layer.Number)); err != nil {
+				return nil, err
+			}
+		} else if acct, err = s.getAccount(addr); err != nil {
 			log.With().Error("unable to fetch projected account state", log.Err(err))
 			return nil, status.Errorf(codes.Internal, "error fetching projected account data")
 		}
@@ -562,3 +621,45 @@ func (s GlobalStateService) GlobalStateStream(in *pb.GlobalStateStreamRequest, s
 		// See https://github.com/spacemeshos/go-spacemesh/issues/2075
 	}
 }
+
+// AccountProof returns an account as it was after a layer was applied, like Account, along with a Merkle proof of it
+// against the layer's state root. The layer defaults to the latest layer in state. The proof holds the encoded trie
+// nodes in order, starting with the root, and can be checked with lightclient.VerifyAccount.
+func (s GlobalStateService) AccountProof(ctx context.Context, in *pb.AccountProofRequest) (*pb.AccountProofResponse, error) {
+	log.Info("GRPC GlobalStateService.AccountProof")
+
+	if in.AccountId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`AccountId` must be provided")
+	}
+	layer := s.Mesh.LatestLayerInState()
+	if in.Layer != nil {
+		layer = types.LayerID(in.Layer.Number)
+	}
+
+	addr := types.BytesToAddress(in.AccountId.Address)
+	proof, err := s.Mesh.GetAccountProof(addr, layer)
+	if err != nil {
+		log.With().Error("unable to prove account state at layer", layer, log.Err(err))
+		return nil, status.Errorf(codes.NotFound, "no state recorded for layer %d", layer)
+	}
+
+	state := &pb.AccountState{
+		Counter: proof.Account.Nonce,
+		Balance: &pb.Amount{Value: proof.Account.Balance},
+	}
+	var control *types.AccountControl
+	if len(proof.Account.Control) > 0 {
+		control = &proof.Account.Control[0]
+	}
+	return &pb.AccountProofResponse{
+		AccountWrapper: &pb.Account{
+			AccountId:      &pb.AccountId{Address: addr.Bytes()},
+			StateCurrent:   state,
+			StateProjected: state,
+			Control:        convertAccountControl(control),
+		},
+		Layer:    &pb.LayerNumber{Number: uint32(proof.Layer)},
+		RootHash: proof.Root.Bytes(),
+		Nodes:    proof.Nodes,
+	}, nil
+}
@@ -22,8 +22,10 @@ import (
 	"github.com/spacemeshos/go-spacemesh/activation"
 	"github.com/spacemeshos/go-spacemesh/cmd"
 	"github.com/spacemeshos/go-spacemesh/common/util"
+	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/events"
 	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
 	"github.com/spacemeshos/go-spacemesh/signing"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc/codes"
@@ -93,6 +95,11 @@ var (
 	txAPI      = &TxAPIMock{
 		returnTx:     make(map[types.TransactionID]*types.Transaction),
 		layerApplied: make(map[types.TransactionID]*types.LayerID),
+		receipts:     make(map[types.TransactionID]*types.Receipt),
+		controls:     make(map[types.Address]*types.AccountControl),
+		atLayer: map[types.LayerID]map[types.Address]*types.AccountState{
+			layerVerified: {addr1: {Nonce: 3, Balance: 42}},
+		},
 		balances: map[types.Address]*big.Int{
 			globalTx.Origin(): big.NewInt(int64(accountBalance)),
 			addr1:             big.NewInt(int64(accountBalance)),
@@ -168,8 +175,13 @@ func (s *NetworkMock) GetErr() bool {
 type TxAPIMock struct {
 	returnTx     map[types.TransactionID]*types.Transaction
 	layerApplied map[types.TransactionID]*types.LayerID
+	receipts     map[types.TransactionID]*types.Receipt
+	controls     map[types.Address]*types.AccountControl
+	atLayer      map[types.LayerID]map[types.Address]*types.AccountState
 	balances     map[types.Address]*big.Int
 	nonces       map[types.Address]uint64
+	pruned       types.LayerID
+	noIndex      bool
 	err          error
 }
 
@@ -218,10 +230,53 @@ func (t *TxAPIMock) LatestLayerInState() types.LayerID {
 	return layerVerified
 }
 
+func (t *TxAPIMock) PrunedLayer() types.LayerID {
+	return t.pruned
+}
+
 func (t *TxAPIMock) GetLayerApplied(txID types.TransactionID) *types.LayerID {
 	return t.layerApplied[txID]
 }
 
+func (t *TxAPIMock) GetReceipt(txID types.TransactionID) (*types.Receipt, error) {
+	receipt, ok := t.receipts[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	if receipt == nil {
+		return nil, errors.New("failed to decode receipt")
+	}
+	return receipt, nil
+}
+
+func (t *TxAPIMock) GetAccountControl(addr types.Address) *types.AccountControl {
+	return t.controls[addr]
+}
+
+func (t *TxAPIMock) GetAccountAtLayer(addr types.Address, layer types.LayerID) (*types.AccountState, error) {
+	accounts, ok := t.atLayer[layer]
+	if !ok {
+		return nil, errors.New("no state root for layer")
+	}
+	if account, ok := accounts[addr]; ok {
+		return account, nil
+	}
+	return &types.AccountState{}, nil
+}
+
+func (t *TxAPIMock) GetAccountProof(addr types.Address, layer types.LayerID) (*types.AccountProof, error) {
+	account, err := t.GetAccountAtLayer(addr, layer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountProof{
+		Layer:   layer,
+		Root:    stateRoot,
+		Account: *account,
+		Nodes:   [][]byte{[]byte("root node"), []byte("leaf node")},
+	}, nil
+}
+
 func (t *TxAPIMock) GetTransaction(id types.TransactionID) (*types.Transaction, error) {
 	tx, ok := t.returnTx[id]
 	if !ok {
@@ -254,6 +309,24 @@ func (t *TxAPIMock) GetRewardsBySmesherID(types.NodeID) (rewards []types.Reward,
 	}, nil
 }
 
+func (t *TxAPIMock) GetSupply(layer types.LayerID) (*types.Supply, error) {
+	// half the layers were rewarded, and a unit of each of their rewards was burned
+	emitted := big.NewInt(rewardAmount * int64(layer+1) / 2)
+	burned := big.NewInt(int64(layer+1) / 2)
+	supplyCap := big.NewInt(rewardAmount * 100)
+	return &types.Supply{
+		Layer:        layer,
+		LayerReward:  big.NewInt(rewardAmount),
+		EpochReward:  big.NewInt(rewardAmount * layersPerEpoch),
+		Scheduled:    big.NewInt(rewardAmount * int64(layer+1)),
+		Emitted:      new(big.Int).Sub(emitted, burned),
+		Burned:       burned,
+		EmittedLayer: layer,
+		Cap:          supplyCap,
+		Remaining:    new(big.Int).Sub(supplyCap, emitted),
+	}, nil
+}
+
 func (t *TxAPIMock) GetTransactionsByDestination(l types.LayerID, account types.Address) (txs []types.TransactionID) {
 	if l != TxReturnLayer {
 		return nil
@@ -297,6 +370,53 @@ func (t *TxAPIMock) GetATXs([]types.ATXID) (map[types.ATXID]*types.ActivationTx,
 	return atxs, nil
 }
 
+func (t *TxAPIMock) GetBlock(id types.BlockID) (*types.Block, error) {
+	for _, b := range []*types.Block{block1, block2, block3} {
+		if b.ID() == id {
+			return b, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func (t *TxAPIMock) GetTransactionBlocks(txID types.TransactionID) (ids []types.BlockID, err error) {
+	if t.noIndex {
+		return nil, mesh.ErrIndexDisabled
+	}
+	for _, b := range []*types.Block{block1, block2, block3} {
+		for _, id := range b.TxIDs {
+			if id == txID {
+				ids = append(ids, b.ID())
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (t *TxAPIMock) GetSmesherBlocks(smesher *signing.PublicKey) (ids []types.BlockID, err error) {
+	if t.noIndex {
+		return nil, mesh.ErrIndexDisabled
+	}
+	for _, b := range []*types.Block{block1, block2, block3} {
+		if b.MinerID().String() == smesher.String() {
+			ids = append(ids, b.ID())
+		}
+	}
+	return ids, nil
+}
+
+func (t *TxAPIMock) GetCoinbaseAtxIDs(coinbase types.Address) (ids []types.ATXID, err error) {
+	if t.noIndex {
+		return nil, mesh.ErrIndexDisabled
+	}
+	for _, atx := range []*types.ActivationTx{globalAtx, globalAtx2} {
+		if atx.Coinbase == coinbase {
+			ids = append(ids, atx.ID())
+		}
+	}
+	return ids, nil
+}
+
 func (t *TxAPIMock) GetTransactions(txids []types.TransactionID) (txs []*types.Transaction, missing map[types.TransactionID]struct{}) {
 	for _, txid := range txids {
 		for _, tx := range t.returnTx {
@@ -364,6 +484,19 @@ func newAtx(challenge types.NIPSTChallenge, nipst *types.NIPST, coinbase types.A
 	return activationTx
 }
 
+// TortoiseAPIMock is a mock for the tortoise inspection API
+type TortoiseAPIMock struct {
+	inspections map[types.LayerID]*types.LayerInspection
+}
+
+func (t *TortoiseAPIMock) InspectLayer(layer types.LayerID) (*types.LayerInspection, error) {
+	inspection, ok := t.inspections[layer]
+	if !ok {
+		return nil, errors.New("layer is outside of the tortoise's window")
+	}
+	return inspection, nil
+}
+
 // MiningAPIMock is a mock for mining API
 type MiningAPIMock struct{}
 
@@ -420,7 +553,11 @@ type MempoolMock struct {
 }
 
 func (m MempoolMock) Get(id types.TransactionID) (*types.Transaction, error) {
-	return m.poolByTxid[id], nil
+	tx, ok := m.poolByTxid[id]
+	if !ok {
+		return nil, errors.New("not in the mempool")
+	}
+	return tx, nil
 }
 
 func (m *MempoolMock) Put(id types.TransactionID, tx *types.Transaction) {
@@ -644,6 +781,70 @@ func TestGlobalStateService(t *testing.T) {
 			require.Equal(t, uint64(accountBalance+1), res.AccountWrapper.StateProjected.Balance.Value)
 			require.Equal(t, uint64(accountCounter+1), res.AccountWrapper.StateProjected.Counter)
 		}},
+		{"Account_Control", func(t *testing.T) {
+			txAPI.controls[addr1] = &types.AccountControl{
+				Threshold:   1,
+				Owners:      []types.Address{addr1, addr2},
+				UnlockLayer: 10,
+			}
+
+			res, err := c.Account(context.Background(), &pb.AccountRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+			})
+			require.NoError(t, err)
+			control := res.AccountWrapper.Control
+			require.NotNil(t, control)
+			require.Equal(t, uint64(1), control.Threshold)
+			require.Len(t, control.Owners, 2)
+			require.Equal(t, addr1.Bytes(), control.Owners[0].Address)
+			require.Equal(t, addr2.Bytes(), control.Owners[1].Address)
+			require.Equal(t, uint32(10), control.UnlockLayer.Number)
+
+			// simple accounts have no control
+			delete(txAPI.controls, addr1)
+			res, err = c.Account(context.Background(), &pb.AccountRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+			})
+			require.NoError(t, err)
+			require.Nil(t, res.AccountWrapper.Control)
+		}},
+		{"Account_AtLayer", func(t *testing.T) {
+			res, err := c.Account(context.Background(), &pb.AccountRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+				Layer:     &pb.LayerNumber{Number: layerVerified},
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(42), res.AccountWrapper.StateCurrent.Balance.Value)
+			require.Equal(t, uint64(3), res.AccountWrapper.StateCurrent.Counter)
+			require.Equal(t, uint64(42), res.AccountWrapper.StateProjected.Balance.Value)
+			require.Equal(t, uint64(3), res.AccountWrapper.StateProjected.Counter)
+
+			_, err = c.Account(context.Background(), &pb.AccountRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+				Layer:     &pb.LayerNumber{Number: layerLatest},
+			})
+			require.Equal(t, codes.NotFound, status.Code(err))
+		}},
+		{"AccountProof", func(t *testing.T) {
+			res, err := c.AccountProof(context.Background(), &pb.AccountProofRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(42), res.AccountWrapper.StateCurrent.Balance.Value)
+			require.Equal(t, uint64(3), res.AccountWrapper.StateCurrent.Counter)
+			require.Equal(t, uint32(layerVerified), res.Layer.Number)
+			require.Equal(t, stateRoot.Bytes(), res.RootHash)
+			require.Equal(t, [][]byte{[]byte("root node"), []byte("leaf node")}, res.Nodes)
+
+			_, err = c.AccountProof(context.Background(), &pb.AccountProofRequest{
+				AccountId: &pb.AccountId{Address: addr1.Bytes()},
+				Layer:     &pb.LayerNumber{Number: layerLatest},
+			})
+			require.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = c.AccountProof(context.Background(), &pb.AccountProofRequest{})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}},
 		{"AccountDataQuery_MissingFilter", func(t *testing.T) {
 			_, err := c.AccountDataQuery(context.Background(), &pb.AccountDataQueryRequest{})
 			require.Error(t, err)
@@ -715,6 +916,22 @@ func TestGlobalStateService(t *testing.T) {
 			checkAccountDataQueryItemReward(t, res.AccountItem[0].Datum)
 			checkAccountDataQueryItemAccount(t, res.AccountItem[1].Datum)
 		}},
+		{"AccountDataQuery_AtLayer", func(t *testing.T) {
+			res, err := c.AccountDataQuery(context.Background(), &pb.AccountDataQueryRequest{
+				Filter: &pb.AccountDataFilter{
+					AccountId: &pb.AccountId{Address: addr1.Bytes()},
+					AccountDataFlags: uint32(pb.AccountDataFlag_ACCOUNT_DATA_FLAG_ACCOUNT |
+						pb.AccountDataFlag_ACCOUNT_DATA_FLAG_REWARD),
+				},
+				Layer: &pb.LayerNumber{Number: layerVerified},
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint32(2), res.TotalResults)
+			checkAccountDataQueryItemReward(t, res.AccountItem[0].Datum)
+			acct := res.AccountItem[1].Datum.(*pb.AccountData_AccountWrapper).AccountWrapper
+			require.Equal(t, uint64(42), acct.StateCurrent.Balance.Value)
+			require.Equal(t, uint64(3), acct.StateCurrent.Counter)
+		}},
 		{"SmesherDataQuery", func(t *testing.T) {
 			res, err := c.SmesherDataQuery(context.Background(), &pb.SmesherDataQueryRequest{
 				SmesherId: &pb.SmesherId{
@@ -1140,6 +1357,82 @@ func TestMeshService(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, uint64(layerAvgSize*txsPerBlock/layerDurationSec), response.MaxTxsPerSecond.Value)
 		}},
+		{"SupplyStats", func(t *testing.T) {
+			res, err := c.SupplyStats(context.Background(), &pb.SupplyStatsRequest{Layer: &pb.LayerNumber{Number: layerLatest}})
+			require.NoError(t, err)
+			require.Equal(t, uint32(layerLatest), res.Layer.Number)
+			require.Equal(t, uint64(2), res.Epoch.Value)
+			require.Equal(t, uint64(rewardAmount), res.LayerReward.Value)
+			require.Equal(t, uint64(rewardAmount*layersPerEpoch), res.EpochReward.Value)
+			require.Equal(t, uint64(rewardAmount*(layerLatest+1)), res.Scheduled.Value)
+			require.Equal(t, uint64(rewardAmount*(layerLatest+1)/2-(layerLatest+1)/2), res.Emitted.Value)
+			require.Equal(t, uint64((layerLatest+1)/2), res.Burned.Value)
+			require.Equal(t, uint32(layerLatest), res.EmittedLayer.Number)
+			require.Equal(t, uint64(rewardAmount*100), res.Cap.Value)
+			require.Equal(t, uint64(rewardAmount*100-rewardAmount*(layerLatest+1)/2), res.Remaining.Value)
+
+			_, err = c.SupplyStats(context.Background(), &pb.SupplyStatsRequest{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "`Layer` must be provided")
+		}},
+		{"TransactionBlocks", func(t *testing.T) {
+			res, err := c.TransactionBlocks(context.Background(), &pb.TransactionBlocksRequest{Id: &pb.TransactionId{Id: globalTx.ID().Bytes()}})
+			require.NoError(t, err)
+			require.Len(t, res.Layer, 1)
+			require.Equal(t, uint32(block1.Layer()), res.Layer[0].Number.Number)
+			require.Len(t, res.Layer[0].Blocks, 1)
+			require.Equal(t, types.Hash20(block1.ID()).Bytes(), res.Layer[0].Blocks[0].Id)
+			require.Len(t, res.Layer[0].Blocks[0].Transactions, 2)
+
+			_, err = c.TransactionBlocks(context.Background(), &pb.TransactionBlocksRequest{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "`Id` must be provided")
+		}},
+		{"SmesherBlocksQuery", func(t *testing.T) {
+			req := &pb.SmesherBlocksQueryRequest{SmesherId: &pb.SmesherId{Id: block2.MinerID().Bytes()}}
+			res, err := c.SmesherBlocksQuery(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), res.TotalResults)
+			require.Len(t, res.Layer, 1)
+			require.Len(t, res.Layer[0].Blocks, 1)
+			require.Equal(t, block2.MinerID().Bytes(), res.Layer[0].Blocks[0].SmesherId.Id)
+
+			// the offset is past the only block
+			req.Offset = 1
+			res, err = c.SmesherBlocksQuery(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), res.TotalResults)
+			require.Empty(t, res.Layer)
+
+			_, err = c.SmesherBlocksQuery(context.Background(), &pb.SmesherBlocksQueryRequest{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "`SmesherId` must be provided")
+		}},
+		{"CoinbaseActivationsQuery", func(t *testing.T) {
+			req := &pb.CoinbaseActivationsQueryRequest{AccountId: &pb.AccountId{Address: addr1.Bytes()}}
+			res, err := c.CoinbaseActivationsQuery(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), res.TotalResults)
+			require.Len(t, res.Activation, 1)
+			checkAccountMeshDataItemActivation(t, &pb.AccountMeshData_Activation{Activation: res.Activation[0]})
+
+			// activations published before the min layer are filtered out
+			req.MinLayer = &pb.LayerNumber{Number: uint32(globalAtx.PubLayerID) + 1}
+			res, err = c.CoinbaseActivationsQuery(context.Background(), req)
+			require.NoError(t, err)
+			require.Zero(t, res.TotalResults)
+
+			_, err = c.CoinbaseActivationsQuery(context.Background(), &pb.CoinbaseActivationsQueryRequest{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "`AccountId` must be provided")
+		}},
+		{"ExplorerIndexDisabled", func(t *testing.T) {
+			txAPI.noIndex = true
+			defer func() { txAPI.noIndex = false }()
+			_, err := c.TransactionBlocks(context.Background(), &pb.TransactionBlocksRequest{Id: &pb.TransactionId{Id: globalTx.ID().Bytes()}})
+			require.Error(t, err)
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		}},
 		{"AccountMeshDataQuery", func(t *testing.T) {
 			subtests := []struct {
 				name string
@@ -1505,6 +1798,19 @@ func TestMeshService(t *testing.T) {
 					}),
 				},
 
+				// start layer was pruned
+				{
+					name: "start layer was pruned",
+					run: func(t *testing.T) {
+						txAPI.pruned = layerFirst + 2
+						defer func() { txAPI.pruned = 0 }()
+						generateRunFnError("layers up to 2 were pruned", &pb.LayersQueryRequest{
+							StartLayer: &pb.LayerNumber{Number: uint32(layerFirst + 1)},
+							EndLayer:   &pb.LayerNumber{Number: uint32(layerVerified)},
+						})(t)
+					},
+				},
+
 				// GOOD INPUTS
 
 				// nil inputs
@@ -1608,7 +1914,7 @@ func TestTransactionServiceSubmitUnsync(t *testing.T) {
 	defer func() { req.NoError(conn.Close()) }()
 	c := pb.NewTransactionServiceClient(conn)
 
-	serializedTx, err := types.InterfaceToBytes(globalTx)
+	serializedTx, err := types.TransactionToBytes(globalTx)
 	req.NoError(err, "error serializing tx")
 
 	// This time, we expect an error, since isSynced is false (by default)
@@ -1656,7 +1962,7 @@ func TestTransactionService(t *testing.T) {
 		run  func(*testing.T)
 	}{
 		{"SubmitTransaction", func(t *testing.T) {
-			serializedTx, err := types.InterfaceToBytes(globalTx)
+			serializedTx, err := types.TransactionToBytes(globalTx)
 			require.NoError(t, err, "error serializing tx")
 			res, err := c.SubmitTransaction(context.Background(), &pb.SubmitTransactionRequest{
 				Transaction: serializedTx,
@@ -1668,7 +1974,7 @@ func TestTransactionService(t *testing.T) {
 		}},
 		{"SubmitTransaction_ZeroBalance", func(t *testing.T) {
 			txAPI.balances[globalTx.Origin()] = big.NewInt(0)
-			serializedTx, err := types.InterfaceToBytes(globalTx)
+			serializedTx, err := types.TransactionToBytes(globalTx)
 			require.NoError(t, err, "error serializing tx")
 			_, err = c.SubmitTransaction(context.Background(), &pb.SubmitTransactionRequest{
 				Transaction: serializedTx,
@@ -1680,7 +1986,7 @@ func TestTransactionService(t *testing.T) {
 		}},
 		{"SubmitTransaction_BadCounter", func(t *testing.T) {
 			txAPI.nonces[globalTx.Origin()] = uint64(accountCounter + 1)
-			serializedTx, err := types.InterfaceToBytes(globalTx)
+			serializedTx, err := types.TransactionToBytes(globalTx)
 			require.NoError(t, err, "error serializing tx")
 			_, err = c.SubmitTransaction(context.Background(), &pb.SubmitTransactionRequest{
 				Transaction: serializedTx,
@@ -1703,7 +2009,7 @@ func TestTransactionService(t *testing.T) {
 		}},
 		{"SubmitTransaction_InvalidAddr", func(t *testing.T) {
 			// this tx origin does not exist in state
-			serializedTx, err := types.InterfaceToBytes(globalTx2)
+			serializedTx, err := types.TransactionToBytes(globalTx2)
 			require.NoError(t, err, "error serializing tx")
 			_, err = c.SubmitTransaction(context.Background(), &pb.SubmitTransactionRequest{
 				Transaction: serializedTx,
@@ -1753,6 +2059,40 @@ func TestTransactionService(t *testing.T) {
 
 			checkTransaction(t, res.Transactions[0])
 		}},
+		{"TransactionReceipt", func(t *testing.T) {
+			req := &pb.TransactionReceiptRequest{Id: &pb.TransactionId{Id: globalTx.ID().Bytes()}}
+			_, err := c.TransactionReceipt(context.Background(), req)
+			require.Equal(t, codes.NotFound, status.Code(err))
+
+			txAPI.receipts[globalTx.ID()] = &types.Receipt{
+				TxID:   globalTx.ID(),
+				Result: types.TxResultBadNonce,
+				Layer:  layerFirst,
+				Reason: "incorrect nonce",
+			}
+			defer delete(txAPI.receipts, globalTx.ID())
+			res, err := c.TransactionReceipt(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, globalTx.ID().Bytes(), res.Receipt.Id.Id)
+			require.Equal(t, pb.TransactionReceipt_TRANSACTION_RESULT_BAD_COUNTER, res.Receipt.Result)
+			require.Equal(t, uint64(0), res.Receipt.GasUsed)
+			require.Equal(t, uint64(0), res.Receipt.Fee.Value)
+			require.Equal(t, uint32(layerFirst), res.Receipt.Layer.Number)
+			require.Equal(t, "incorrect nonce", res.Receipt.Reason)
+
+			txAPI.receipts[globalTx.ID()].Result = types.TxResultUnauthorized
+			res, err = c.TransactionReceipt(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, pb.TransactionReceipt_TRANSACTION_RESULT_UNAUTHORIZED, res.Receipt.Result)
+
+			// a receipt that can't be read isn't reported as missing
+			txAPI.receipts[globalTx.ID()] = nil
+			_, err = c.TransactionReceipt(context.Background(), req)
+			require.Equal(t, codes.Internal, status.Code(err))
+
+			_, err = c.TransactionReceipt(context.Background(), &pb.TransactionReceiptRequest{})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}},
 		{"TransactionsStateStream_MissingTransactionId", func(t *testing.T) {
 			req := &pb.TransactionsStateStreamRequest{}
 			stream, err := c.TransactionsStateStream(context.Background(), req)
@@ -1825,6 +2165,59 @@ func TestTransactionService(t *testing.T) {
 			events.ReportNewTx(globalTx)
 			wg.Wait()
 		}},
+		{"TransactionsStateStream_Replaced", func(t *testing.T) {
+			req := &pb.TransactionsStateStreamRequest{}
+			req.TransactionId = append(req.TransactionId, &pb.TransactionId{
+				Id: globalTx.ID().Bytes(),
+			})
+
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				stream, err := c.TransactionsStateStream(context.Background(), req)
+				require.NoError(t, err)
+				res, err := stream.Recv()
+				require.NoError(t, err)
+				require.Equal(t, globalTx.ID().Bytes(), res.TransactionState.Id.Id)
+				require.Equal(t, pb.TransactionState_TRANSACTION_STATE_REPLACED, res.TransactionState.State)
+			}()
+
+			events.CloseEventReporter()
+			err := events.InitializeEventReporterWithOptions("", 1, true)
+			require.NoError(t, err)
+			events.ReportTxReplaced(globalTx)
+			wg.Wait()
+		}},
+		{"TransactionsStateStream_Reorg", func(t *testing.T) {
+			req := &pb.TransactionsStateStreamRequest{}
+			req.TransactionId = append(req.TransactionId, &pb.TransactionId{
+				Id: globalTx.ID().Bytes(),
+			})
+
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				stream, err := c.TransactionsStateStream(context.Background(), req)
+				require.NoError(t, err)
+				res, err := stream.Recv()
+				require.NoError(t, err)
+				require.Equal(t, globalTx.ID().Bytes(), res.TransactionState.Id.Id)
+				// the tx is no longer in a valid block, and it isn't in the mempool
+				require.Equal(t, pb.TransactionState_TRANSACTION_STATE_MESH, res.TransactionState.State)
+			}()
+
+			events.CloseEventReporter()
+			err := events.InitializeEventReporterWithOptions("", 1, true)
+			require.NoError(t, err)
+			events.ReportReorg(events.Reorg{Layers: []events.LayerReorg{{
+				Layer:    layerFirst,
+				OldValid: []types.BlockID{block1.ID()},
+				NewValid: []types.BlockID{block2.ID()},
+			}}})
+			wg.Wait()
+		}},
 		// Submit a tx, then receive it over the stream
 		{"TransactionsState_SubmitThenStream", func(t *testing.T) {
 			// Remove the tx from the mesh so it only appears in the mempool
@@ -1877,7 +2270,7 @@ func TestTransactionService(t *testing.T) {
 			events.CloseEventReporter()
 			err := events.InitializeEventReporterWithOptions("", 1, true)
 			require.NoError(t, err)
-			serializedTx, err := types.InterfaceToBytes(globalTx)
+			serializedTx, err := types.TransactionToBytes(globalTx)
 			require.NoError(t, err, "error serializing tx")
 			res, err := c.SubmitTransaction(context.Background(), &pb.SubmitTransactionRequest{
 				Transaction: serializedTx,
@@ -1902,6 +2295,7 @@ func checkTransaction(t *testing.T, tx *pb.Transaction) {
 	require.Equal(t, globalTx.ID().Bytes(), tx.Id.Id)
 	require.Equal(t, globalTx.Origin().Bytes(), tx.Sender.Address)
 	require.Equal(t, globalTx.GasLimit, tx.GasOffered.GasProvided)
+	require.Equal(t, globalTx.Fee, tx.GasOffered.GasPrice)
 	require.Equal(t, globalTx.Amount, tx.Amount.Value)
 	require.Equal(t, globalTx.AccountNonce, tx.Counter)
 	require.Equal(t, globalTx.Origin().Bytes(), tx.Signature.PublicKey)
@@ -1909,11 +2303,30 @@ func checkTransaction(t *testing.T, tx *pb.Transaction) {
 	case *pb.Transaction_CoinTransfer:
 		require.Equal(t, globalTx.Recipient.Bytes(), x.CoinTransfer.Receiver.Address,
 			"inner coin transfer tx has bad recipient")
+		require.Len(t, x.CoinTransfer.Transfers, 1)
+		require.Equal(t, globalTx.Recipient.Bytes(), x.CoinTransfer.Transfers[0].Receiver.Address)
+		require.Equal(t, globalTx.Amount, x.CoinTransfer.Transfers[0].Amount.Value)
 	default:
 		require.Fail(t, "inner tx has wrong tx data type")
 	}
 }
 
+func TestConvertTransaction_Batch(t *testing.T) {
+	transfers := []types.Transfer{{Recipient: addr1, Amount: 10}, {Recipient: addr2, Amount: 20}}
+	tx, err := types.NewSignedBatchTx(0, transfers, 100, 1, signing.NewEdSigner())
+	require.NoError(t, err)
+
+	res := convertTransaction(tx)
+	require.Equal(t, uint64(30), res.Amount.Value)
+	coinTransfer := res.Datum.(*pb.Transaction_CoinTransfer).CoinTransfer
+	require.Equal(t, types.Address{}.Bytes(), coinTransfer.Receiver.Address)
+	require.Len(t, coinTransfer.Transfers, 2)
+	for i, transfer := range transfers {
+		require.Equal(t, transfer.Recipient.Bytes(), coinTransfer.Transfers[i].Receiver.Address)
+		require.Equal(t, transfer.Amount, coinTransfer.Transfers[i].Amount.Value)
+	}
+}
+
 func checkLayer(t *testing.T, l *pb.Layer) {
 	require.Equal(t, uint32(0), l.Number.Number, "first layer is zero")
 	require.Equal(t, pb.Layer_LAYER_STATUS_CONFIRMED, l.Status, "first layer is confirmed")
@@ -1965,6 +2378,7 @@ func checkLayer(t *testing.T, l *pb.Layer) {
 	require.Equal(t, globalTx.ID().Bytes(), resTx.Id.Id)
 	require.Equal(t, globalTx.Origin().Bytes(), resTx.Sender.Address)
 	require.Equal(t, globalTx.GasLimit, resTx.GasOffered.GasProvided)
+	require.Equal(t, globalTx.Fee, resTx.GasOffered.GasPrice)
 	require.Equal(t, globalTx.Amount, resTx.Amount.Value)
 	require.Equal(t, globalTx.AccountNonce, resTx.Counter)
 	require.Equal(t, globalTx.Signature[:], resTx.Signature.Signature)
@@ -2321,6 +2735,53 @@ func TestLayerStream_comprehensive(t *testing.T) {
 	wg.Wait()
 }
 
+func TestLayerStream_reorg(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	grpcService := NewMeshService(txAPI, mempoolMock, &genTime, layersPerEpoch, networkID, layerDurationSec, layerAvgSize, txsPerBlock)
+	shutDown := launchServer(t, grpcService)
+	defer shutDown()
+
+	addr := "localhost:" + strconv.Itoa(cfg.GrpcServerPort)
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		c := pb.NewMeshServiceClient(conn)
+		stream, err := c.LayerStream(context.Background(), &pb.LayerStreamRequest{})
+		require.NoError(t, err, "stream request returned unexpected error")
+
+		// the layer is sent again with only its new valid blocks
+		res, err := stream.Recv()
+		require.NoError(t, err, "got error from stream")
+		require.Equal(t, uint32(layerFirst), res.Layer.Number.Number)
+		require.Equal(t, pb.Layer_LAYER_STATUS_CONFIRMED, res.Layer.Status)
+		require.Len(t, res.Layer.Blocks, 1)
+		require.Equal(t, types.Hash20(block2.ID()).Bytes(), res.Layer.Blocks[0].Id)
+
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err, "expected EOF from stream")
+	}()
+
+	require.NoError(t, events.InitializeEventReporterWithOptions("", 0, true))
+	events.ReportReorg(events.Reorg{Layers: []events.LayerReorg{{
+		Layer:    layerFirst,
+		OldValid: []types.BlockID{block1.ID(), block2.ID()},
+		NewValid: []types.BlockID{block2.ID()},
+	}}})
+	events.CloseEventReporter()
+
+	wg.Wait()
+}
+
 func checkAccountDataQueryItemAccount(t *testing.T, dataItem interface{}) {
 	switch x := dataItem.(type) {
 	case *pb.AccountData_AccountWrapper:
@@ -2564,7 +3025,31 @@ func TestJsonApi(t *testing.T) {
 }
 
 func TestDebugService(t *testing.T) {
-	svc := NewDebugService(txAPI)
+	tortoiseAPI := &TortoiseAPIMock{inspections: map[types.LayerID]*types.LayerInspection{
+		layerFirst + 1: {
+			Layer:     layerFirst + 1,
+			Last:      layerFirst + 3,
+			Verified:  layerFirst,
+			Threshold: 6,
+			Blocks: []types.BlockInspection{{
+				ID:            block1.ID(),
+				Good:          true,
+				Weight:        1,
+				Input:         "support",
+				Tally:         types.VoteTally{Support: 4},
+				GlobalOpinion: "abstain",
+				Votes: []types.BlockVote{{
+					Voter:  block2.ID(),
+					Layer:  layerFirst + 2,
+					Good:   true,
+					Weight: 4,
+					Vote:   types.VoteTally{Support: 1},
+				}},
+			}},
+			Reason: "the good blocks' votes don't reach the threshold",
+		},
+	}}
+	svc := NewDebugService(txAPI, tortoiseAPI)
 	shutDown := launchServer(t, svc)
 	defer shutDown()
 
@@ -2595,6 +3080,27 @@ func TestDebugService(t *testing.T) {
 			require.Contains(t, addresses, globalTx.Origin().Bytes())
 			require.Contains(t, addresses, addr1.Bytes())
 		}},
+		{"TortoiseLayer", func(t *testing.T) {
+			_, err := c.TortoiseLayer(context.Background(), &pb.TortoiseLayerRequest{})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = c.TortoiseLayer(context.Background(), &pb.TortoiseLayerRequest{Layer: &pb.LayerNumber{Number: layerFirst + 5}})
+			require.Equal(t, codes.NotFound, status.Code(err))
+
+			res, err := c.TortoiseLayer(context.Background(), &pb.TortoiseLayerRequest{Layer: &pb.LayerNumber{Number: layerFirst + 1}})
+			require.NoError(t, err)
+			require.Equal(t, uint32(layerFirst+3), res.Last.Number)
+			require.Equal(t, float64(6), res.Threshold)
+			require.Equal(t, "the good blocks' votes don't reach the threshold", res.Reason)
+			require.Len(t, res.Blocks, 1)
+			block := res.Blocks[0]
+			require.Equal(t, block1.ID().Bytes(), block.Id)
+			require.True(t, block.Good)
+			require.Equal(t, uint64(4), block.Support)
+			require.Equal(t, pb.TortoiseOpinion_TORTOISE_OPINION_ABSTAIN, block.GlobalOpinion)
+			require.Len(t, block.Votes, 1)
+			require.Equal(t, block2.ID().Bytes(), block.Votes[0].Voter)
+			require.Equal(t, uint64(4), block.Votes[0].Weight)
+		}},
 	}
 
 	// Run subtests
@@ -2,11 +2,16 @@ package grpcserver
 
 import (
 	"fmt"
+	"math/big"
+	"sort"
+
 	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
 	"github.com/spacemeshos/go-spacemesh/api"
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/events"
 	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+	"github.com/spacemeshos/go-spacemesh/signing"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -103,6 +108,45 @@ func (s MeshService) MaxTransactionsPerSecond(context.Context, *pb.MaxTransactio
 	}}, nil
 }
 
+// SupplyStats returns the scheduled emission of layer rewards as of the requested layer, which may be in the future,
+// and the amounts actually emitted and burned as of the layer, or as of the latest layer in state if it's earlier.
+func (s MeshService) SupplyStats(_ context.Context, in *pb.SupplyStatsRequest) (*pb.SupplyStatsResponse, error) {
+	log.Info("GRPC MeshService.SupplyStats")
+	if in.Layer == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`Layer` must be provided")
+	}
+	layer := types.LayerID(in.Layer.Number)
+	supply, err := s.Mesh.GetSupply(layer)
+	if err != nil {
+		log.With().Error("unable to read supply", layer, log.Err(err))
+		return nil, status.Errorf(codes.Internal, "error reading supply stats")
+	}
+	amounts := []*big.Int{supply.LayerReward, supply.EpochReward, supply.Scheduled, supply.Emitted, supply.Burned}
+	if supply.Cap != nil {
+		amounts = append(amounts, supply.Cap, supply.Remaining)
+	}
+	for _, amount := range amounts {
+		if !amount.IsUint64() {
+			return nil, status.Errorf(codes.OutOfRange, "supply stats of layer %d don't fit in 64 bits", layer)
+		}
+	}
+	res := &pb.SupplyStatsResponse{
+		Layer:        &pb.LayerNumber{Number: in.Layer.Number},
+		Epoch:        &pb.SimpleInt{Value: uint64(layer.GetEpoch())},
+		LayerReward:  &pb.Amount{Value: supply.LayerReward.Uint64()},
+		EpochReward:  &pb.Amount{Value: supply.EpochReward.Uint64()},
+		Scheduled:    &pb.Amount{Value: supply.Scheduled.Uint64()},
+		Emitted:      &pb.Amount{Value: supply.Emitted.Uint64()},
+		EmittedLayer: &pb.LayerNumber{Number: uint32(supply.EmittedLayer)},
+		Burned:       &pb.Amount{Value: supply.Burned.Uint64()},
+	}
+	if supply.Cap != nil {
+		res.Cap = &pb.Amount{Value: supply.Cap.Uint64()}
+		res.Remaining = &pb.Amount{Value: supply.Remaining.Uint64()}
+	}
+	return res, nil
+}
+
 // QUERIES
 
 func (s MeshService) getFilteredTransactions(startLayer types.LayerID, addr types.Address) (txs []*types.Transaction, err error) {
@@ -255,24 +299,50 @@ func (s MeshService) getTxIdsFromMesh(minLayer types.LayerID, addr types.Address
 	return txIDs
 }
 
+// isTxParty returns true if addr is the origin of the tx or the recipient of any of its transfers
+func isTxParty(t *types.Transaction, addr types.Address) bool {
+	if t.Origin() == addr {
+		return true
+	}
+	for _, transfer := range t.Transfers() {
+		if transfer.Recipient == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func convertTransaction(t *types.Transaction) *pb.Transaction {
+	// batches have no single receiver, so they're returned with an empty one and their total amount, along with each
+	// of their transfers. Account creations are returned with the created account as their receiver
+	amount, _ := t.TotalAmount()
+	var transfers []*pb.CoinTransfer
+	for _, transfer := range t.Transfers() {
+		transfers = append(transfers, &pb.CoinTransfer{
+			Receiver: &pb.AccountId{Address: transfer.Recipient.Bytes()},
+			Amount:   &pb.Amount{Value: transfer.Amount},
+		})
+	}
+	receiver := t.Recipient
+	if t.Type == types.TxTypeCreateAccount {
+		receiver = t.CreatedAccount()
+	}
 	return &pb.Transaction{
 		Id: &pb.TransactionId{Id: t.ID().Bytes()},
 		Datum: &pb.Transaction_CoinTransfer{
 			CoinTransfer: &pb.CoinTransferTransaction{
-				Receiver: &pb.AccountId{Address: t.Recipient.Bytes()},
+				Receiver:  &pb.AccountId{Address: receiver.Bytes()},
+				Transfers: transfers,
 			},
 		},
 		Sender: &pb.AccountId{Address: t.Origin().Bytes()},
 		GasOffered: &pb.GasOffered{
-			// We don't currently implement gas price. t.Fee is the gas actually paid
-			// by the tx; GasLimit is the max gas. MeshService is concerned with the
-			// pre-STF tx, which includes a gas offer but not an amount of gas actually
-			// consumed.
-			//GasPrice:    nil,
+			// MeshService is concerned with the pre-STF tx, which includes a gas offer but
+			// not an amount of gas actually consumed.
+			GasPrice:    t.GasPrice(),
 			GasProvided: t.GasLimit,
 		},
-		Amount:  &pb.Amount{Value: t.Amount},
+		Amount:  &pb.Amount{Value: amount},
 		Counter: t.AccountNonce,
 		Signature: &pb.Signature{
 			Scheme:    pb.Signature_SCHEME_ED25519_PLUS_PLUS,
@@ -293,6 +363,73 @@ func convertActivation(a *types.ActivationTx) (*pb.Activation, error) {
 	}, nil
 }
 
+func (s MeshService) readBlock(b *types.Block) (*pb.Block, error) {
+	txs, missing := s.Mesh.GetTransactions(b.TxIDs)
+	// TODO: Do we ever expect txs to be missing here?
+	// E.g., if this node has not synced/received them yet.
+	if len(missing) != 0 {
+		log.With().Error("could not find transactions from block",
+			log.String("missing", fmt.Sprint(missing)), b.ID(), b.Layer())
+		return nil, status.Errorf(codes.Internal, "error retrieving tx data")
+	}
+
+	var pbTxs []*pb.Transaction
+	for _, t := range txs {
+		pbTxs = append(pbTxs, convertTransaction(t))
+	}
+	return &pb.Block{
+		Id:           types.Hash20(b.ID()).Bytes(),
+		Transactions: pbTxs,
+		SmesherId:    &pb.SmesherId{Id: b.MinerID().Bytes()},
+		ActivationId: &pb.ActivationId{Id: b.ATXID.Bytes()},
+	}, nil
+}
+
+// readBlocks returns the blocks grouped by layer, in order of layer. The layers only carry their number, status and the
+// given blocks.
+func (s MeshService) readBlocks(ids []types.BlockID) ([]*pb.Layer, error) {
+	layers := []*pb.Layer{}
+	for _, id := range ids {
+		b, err := s.Mesh.GetBlock(id)
+		if err != nil {
+			log.With().Error("error retrieving block data", id, log.Err(err))
+			return nil, status.Errorf(codes.Internal, "error retrieving block data")
+		}
+		pbBlock, err := s.readBlock(b)
+		if err != nil {
+			return nil, err
+		}
+		var pbLayer *pb.Layer
+		for _, l := range layers {
+			if l.Number.Number == uint32(b.Layer()) {
+				pbLayer = l
+			}
+		}
+		if pbLayer == nil {
+			pbLayer = &pb.Layer{
+				Number: &pb.LayerNumber{Number: uint32(b.Layer())},
+				Status: s.layerStatus(b.Layer()),
+			}
+			layers = append(layers, pbLayer)
+		}
+		pbLayer.Blocks = append(pbLayer.Blocks, pbBlock)
+	}
+	sort.Slice(layers, func(i, j int) bool { return layers[i].Number.Number < layers[j].Number.Number })
+	return layers, nil
+}
+
+// layerStatus returns the status of a layer according to the latest layers that passed both consensus engines. It may
+// be either, or both, but Tortoise always takes precedence.
+func (s MeshService) layerStatus(layer types.LayerID) pb.Layer_LayerStatus {
+	if layer <= s.Mesh.ProcessedLayer() {
+		return pb.Layer_LAYER_STATUS_CONFIRMED
+	}
+	if layer <= s.Mesh.LatestLayerInState() {
+		return pb.Layer_LAYER_STATUS_APPROVED
+	}
+	return pb.Layer_LAYER_STATUS_UNSPECIFIED
+}
+
 func (s MeshService) readLayer(layer *types.Layer, layerStatus pb.Layer_LayerStatus) (*pb.Layer, error) {
 	// Load all block data
 	var blocks []*pb.Block
@@ -301,29 +438,16 @@ func (s MeshService) readLayer(layer *types.Layer, layerStatus pb.Layer_LayerSta
 	var activations []types.ATXID
 
 	for _, b := range layer.Blocks() {
-		txs, missing := s.Mesh.GetTransactions(b.TxIDs)
-		// TODO: Do we ever expect txs to be missing here?
-		// E.g., if this node has not synced/received them yet.
-		if len(missing) != 0 {
-			log.With().Error("could not find transactions from layer",
-				log.String("missing", fmt.Sprint(missing)), layer.Index())
-			return nil, status.Errorf(codes.Internal, "error retrieving tx data")
+		pbBlock, err := s.readBlock(b)
+		if err != nil {
+			return nil, err
 		}
 
 		if b.ActiveSet != nil {
 			activations = append(activations, *b.ActiveSet...)
 		}
 
-		var pbTxs []*pb.Transaction
-		for _, t := range txs {
-			pbTxs = append(pbTxs, convertTransaction(t))
-		}
-		blocks = append(blocks, &pb.Block{
-			Id:           types.Hash20(b.ID()).Bytes(),
-			Transactions: pbTxs,
-			SmesherId:    &pb.SmesherId{Id: b.MinerID().Bytes()},
-			ActivationId: &pb.ActivationId{Id: b.ATXID.Bytes()},
-		})
+		blocks = append(blocks, pbBlock)
 	}
 
 	// Extract ATX data from block data
@@ -374,6 +498,11 @@ func (s MeshService) LayersQuery(_ context.Context, in *pb.LayersQueryRequest) (
 		endLayer = types.LayerID(in.EndLayer.Number)
 	}
 
+	// The blocks and transactions of pruned layers are no longer kept
+	if pruned := s.Mesh.PrunedLayer(); pruned > 0 && startLayer <= pruned {
+		return nil, status.Errorf(codes.OutOfRange, "layers up to %d were pruned, `StartLayer` must be after them", pruned)
+	}
+
 	// Get the latest layers that passed both consensus engines.
 	lastLayerPassedHare := s.Mesh.LatestLayerInState()
 	lastLayerPassedTortoise := s.Mesh.ProcessedLayer()
@@ -485,7 +614,7 @@ func (s MeshService) AccountMeshDataStream(in *pb.AccountMeshDataStreamRequest,
 				return nil
 			}
 			// Apply address filter
-			if tx.Valid && (tx.Transaction.Origin() == addr || tx.Transaction.Recipient == addr) {
+			if tx.Valid && isTxParty(tx.Transaction, addr) {
 				if err := stream.Send(&pb.AccountMeshDataStreamResponse{
 					Datum: &pb.AccountMeshData{
 						Datum: &pb.AccountMeshData_Transaction{
@@ -509,6 +638,7 @@ func (s MeshService) AccountMeshDataStream(in *pb.AccountMeshDataStreamRequest,
 func (s MeshService) LayerStream(_ *pb.LayerStreamRequest, stream pb.MeshService_LayerStreamServer) error {
 	log.Info("GRPC MeshService.LayerStream")
 	layerStream := events.GetLayerChannel()
+	reorgStream := events.GetReorgChannel()
 
 	for {
 		select {
@@ -524,6 +654,26 @@ func (s MeshService) LayerStream(_ *pb.LayerStreamRequest, stream pb.MeshService
 			if err := stream.Send(&pb.LayerStreamResponse{Layer: pbLayer}); err != nil {
 				return err
 			}
+		case reorg, ok := <-reorgStream:
+			if !ok {
+				log.Info("LayerStream closed, shutting down")
+				return nil
+			}
+			// the api has no reorg message yet, so the affected layers are sent again with only their new valid
+			// blocks, which replace the blocks sent for them before
+			for _, l := range reorg.Layers {
+				blocks, err := s.getBlocks(l.NewValid)
+				if err != nil {
+					return err
+				}
+				pbLayer, err := s.readLayer(types.NewExistingLayer(l.Layer, blocks), s.layerStatus(l.Layer))
+				if err != nil {
+					return err
+				}
+				if err := stream.Send(&pb.LayerStreamResponse{Layer: pbLayer}); err != nil {
+					return err
+				}
+			}
 		case <-stream.Context().Done():
 			log.Info("LayerStream closing stream, client disconnected")
 			return nil
@@ -533,6 +683,20 @@ func (s MeshService) LayerStream(_ *pb.LayerStreamRequest, stream pb.MeshService
 	}
 }
 
+// getBlocks reads the blocks with the given IDs.
+func (s MeshService) getBlocks(ids []types.BlockID) ([]*types.Block, error) {
+	blocks := make([]*types.Block, 0, len(ids))
+	for _, id := range ids {
+		b, err := s.Mesh.GetBlock(id)
+		if err != nil {
+			log.With().Error("error retrieving block data", id, log.Err(err))
+			return nil, status.Errorf(codes.Internal, "error retrieving block data")
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
+
 func convertLayerStatus(in int) pb.Layer_LayerStatus {
 	switch in {
 	case events.LayerStatusTypeApproved:
@@ -543,3 +707,113 @@ func convertLayerStatus(in int) pb.Layer_LayerStatus {
 		return pb.Layer_LAYER_STATUS_UNSPECIFIED
 	}
 }
+
+// EXPLORER QUERIES
+// These are served from secondary indexes, and return codes.FailedPrecondition if the node doesn't maintain them.
+
+// indexError converts an error returned by a secondary index to a grpc error.
+func indexError(err error) error {
+	if err == mesh.ErrIndexDisabled {
+		return status.Errorf(codes.FailedPrecondition, "explorer index is disabled on this node")
+	}
+	log.With().Error("error reading explorer index", log.Err(err))
+	return status.Errorf(codes.Internal, "error reading explorer index")
+}
+
+// paginate returns the range of the results to return for the offset and max results of a query, where max results of
+// zero means unlimited.
+func paginate(total int, offset, maxResults uint32) (start, end int) {
+	if offset > uint32(total) {
+		return total, total
+	}
+	if maxResults == 0 || offset+maxResults > uint32(total) {
+		maxResults = uint32(total) - offset
+	}
+	return int(offset), int(offset + maxResults)
+}
+
+// TransactionBlocks returns the blocks that include the transaction, grouped by layer.
+func (s MeshService) TransactionBlocks(_ context.Context, in *pb.TransactionBlocksRequest) (*pb.TransactionBlocksResponse, error) {
+	log.Info("GRPC MeshService.TransactionBlocks")
+
+	if in.Id == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`Id` must be provided")
+	}
+	if len(in.Id.Id) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "`Id.Id` must be provided")
+	}
+	ids, err := s.Mesh.GetTransactionBlocks(types.TransactionID(types.BytesToHash(in.Id.Id)))
+	if err != nil {
+		return nil, indexError(err)
+	}
+	layers, err := s.readBlocks(ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TransactionBlocksResponse{Layer: layers}, nil
+}
+
+// SmesherBlocksQuery returns the blocks produced by a smesher, identified by its public key as in the SmesherId of
+// blocks, grouped by layer. The blocks are paginated in order of layer with Offset and MaxResults, and TotalResults is
+// the number of blocks the smesher produced.
+func (s MeshService) SmesherBlocksQuery(_ context.Context, in *pb.SmesherBlocksQueryRequest) (*pb.SmesherBlocksQueryResponse, error) {
+	log.Info("GRPC MeshService.SmesherBlocksQuery")
+
+	if in.SmesherId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`SmesherId` must be provided")
+	}
+	if in.SmesherId.Id == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`SmesherId.Id` must be provided")
+	}
+	ids, err := s.Mesh.GetSmesherBlocks(signing.NewPublicKey(in.SmesherId.Id))
+	if err != nil {
+		return nil, indexError(err)
+	}
+	start, end := paginate(len(ids), in.Offset, in.MaxResults)
+	layers, err := s.readBlocks(ids[start:end])
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SmesherBlocksQueryResponse{Layer: layers, TotalResults: uint32(len(ids))}, nil
+}
+
+// CoinbaseActivationsQuery returns the activations that set the account as their coinbase, published at or after
+// MinLayer, in order of layer. They're paginated with Offset and MaxResults, and TotalResults is the number of matching
+// activations.
+func (s MeshService) CoinbaseActivationsQuery(_ context.Context, in *pb.CoinbaseActivationsQueryRequest) (*pb.CoinbaseActivationsQueryResponse, error) {
+	log.Info("GRPC MeshService.CoinbaseActivationsQuery")
+
+	if in.AccountId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "`AccountId` must be provided")
+	}
+	var minLayer types.LayerID
+	if in.MinLayer != nil {
+		minLayer = types.LayerID(in.MinLayer.Number)
+	}
+
+	ids, err := s.Mesh.GetCoinbaseAtxIDs(types.BytesToAddress(in.AccountId.Address))
+	if err != nil {
+		return nil, indexError(err)
+	}
+	atxs, matxs := s.Mesh.GetATXs(ids)
+	if len(matxs) != 0 {
+		log.Error("could not find activations %v", matxs)
+		return nil, status.Errorf(codes.Internal, "error retrieving activations data")
+	}
+	res := &pb.CoinbaseActivationsQueryResponse{}
+	for _, id := range ids {
+		atx := atxs[id]
+		if atx.PubLayerID < minLayer {
+			continue
+		}
+		pbatx, err := convertActivation(atx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error serializing activation data")
+		}
+		res.Activation = append(res.Activation, pbatx)
+	}
+	res.TotalResults = uint32(len(res.Activation))
+	start, end := paginate(len(res.Activation), in.Offset, in.MaxResults)
+	res.Activation = res.Activation[start:end]
+	return res, nil
+}
@@ -6,6 +6,7 @@ import (
 	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
 	"github.com/spacemeshos/go-spacemesh/api"
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/events"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/state"
@@ -160,6 +161,27 @@ func (s TransactionService) TransactionsState(_ context.Context, in *pb.Transact
 	return res, nil
 }
 
+// TransactionReceipt returns the receipt recorded when a tx was applied to the global state, or when it failed to apply
+func (s TransactionService) TransactionReceipt(_ context.Context, in *pb.TransactionReceiptRequest) (*pb.TransactionReceiptResponse, error) {
+	log.Info("GRPC TransactionService.TransactionReceipt")
+
+	if in.Id == nil || len(in.Id.Id) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "`Id` must include a transaction ID")
+	}
+
+	txid := types.TransactionID{}
+	copy(txid[:], in.Id.Id)
+	receipt, err := s.Mesh.GetReceipt(txid)
+	if err == database.ErrNotFound {
+		return nil, status.Error(codes.NotFound, "no receipt found for this transaction, it was not applied yet")
+	}
+	if err != nil {
+		log.With().Error("failed to read receipt of transaction", txid, log.Err(err))
+		return nil, status.Error(codes.Internal, "error reading the transaction's receipt")
+	}
+	return &pb.TransactionReceiptResponse{Receipt: convertReceipt(receipt)}, nil
+}
+
 // STREAMS
 
 // TransactionsStateStream exposes a stream of tx data
@@ -174,6 +196,8 @@ func (s TransactionService) TransactionsStateStream(in *pb.TransactionsStateStre
 	channelTx := events.GetNewTxChannel()
 	// The layer channel tells us about status updates
 	channelLayer := events.GetLayerChannel()
+	// The reorg channel tells us about blocks that the tortoise no longer considers valid, or now does
+	channelReorg := events.GetReorgChannel()
 
 	for {
 		select {
@@ -193,9 +217,16 @@ func (s TransactionService) TransactionsStateStream(in *pb.TransactionsStateStre
 					// If the tx was just invalidated, we already know its state.
 					// If not, read it from the database.
 					var txstate pb.TransactionState_TransactionState
-					if tx.Valid {
+					switch {
+					case tx.Valid:
 						_, txstate = s.getTransactionAndStatus(tx.Transaction.ID())
-					} else {
+					case tx.Reason == events.TxDropReasonEvicted:
+						// the API has no dedicated state for evicted txs: they were dropped before
+						// reaching the mesh, so from the client's point of view they were rejected
+						txstate = pb.TransactionState_TRANSACTION_STATE_REJECTED
+					case tx.Reason == events.TxDropReasonReplaced:
+						txstate = pb.TransactionState_TRANSACTION_STATE_REPLACED
+					default:
 						txstate = pb.TransactionState_TRANSACTION_STATE_CONFLICTING
 					}
 
@@ -276,6 +307,51 @@ func (s TransactionService) TransactionsStateStream(in *pb.TransactionsStateStre
 					}
 				}
 			}
+		case reorg, ok := <-channelReorg:
+			if !ok {
+				log.Info("reorg channel closed, shutting down")
+				return nil
+			}
+			for _, l := range reorg.Layers {
+				oldTxs := s.blockTxIDs(l.OldValid)
+				newTxs := s.blockTxIDs(l.NewValid)
+				for _, inputTxID := range in.TransactionId {
+					var arrayID [32]byte
+					copy(arrayID[:], inputTxID.Id[:])
+					txid := types.TransactionID(arrayID)
+					_, wasValid := oldTxs[txid]
+					_, isValid := newTxs[txid]
+					if wasValid == isValid {
+						continue
+					}
+					// txs that are no longer in a valid block are back in the mempool, unless they became invalid
+					// meanwhile, in which case they're only in the mesh
+					txstate := pb.TransactionState_TRANSACTION_STATE_PROCESSED
+					if !isValid {
+						txstate = pb.TransactionState_TRANSACTION_STATE_MESH
+						if _, err := s.Mempool.Get(txid); err == nil {
+							txstate = pb.TransactionState_TRANSACTION_STATE_MEMPOOL
+						}
+					}
+					res := &pb.TransactionsStateStreamResponse{
+						TransactionState: &pb.TransactionState{
+							Id:    inputTxID,
+							State: txstate,
+						},
+					}
+					if in.IncludeTransactions {
+						tx, err := s.Mesh.GetTransaction(txid)
+						if err != nil {
+							log.Error("could not find transaction %v from layer %v: %v", txid, l.Layer, err)
+							return status.Error(codes.Internal, "error retrieving tx data")
+						}
+						res.Transaction = convertTransaction(tx)
+					}
+					if err := stream.Send(res); err != nil {
+						return err
+					}
+				}
+			}
 		case <-stream.Context().Done():
 			log.Info("TransactionsStateStream closing stream, client disconnected")
 			return nil
@@ -284,3 +360,45 @@ func (s TransactionService) TransactionsStateStream(in *pb.TransactionsStateStre
 		// that the service needs to shut down?
 	}
 }
+
+// blockTxIDs returns the set of the IDs of the transactions in the blocks. Blocks that can't be read are skipped.
+func (s TransactionService) blockTxIDs(ids []types.BlockID) map[types.TransactionID]struct{} {
+	txids := make(map[types.TransactionID]struct{})
+	for _, id := range ids {
+		b, err := s.Mesh.GetBlock(id)
+		if err != nil {
+			log.With().Error("error retrieving block data", id, log.Err(err))
+			continue
+		}
+		for _, txid := range b.TxIDs {
+			txids[txid] = struct{}{}
+		}
+	}
+	return txids
+}
+
+func convertReceipt(receipt *types.Receipt) *pb.TransactionReceipt {
+	var result pb.TransactionReceipt_TransactionResult
+	switch receipt.Result {
+	case types.TxResultApplied:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_EXECUTED
+	case types.TxResultBadNonce:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_BAD_COUNTER
+	case types.TxResultInsufficientGas:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_INSUFFICIENT_GAS
+	case types.TxResultInsufficientFunds:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_INSUFFICIENT_FUNDS
+	case types.TxResultUnauthorized:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_UNAUTHORIZED
+	default:
+		result = pb.TransactionReceipt_TRANSACTION_RESULT_UNSPECIFIED
+	}
+	return &pb.TransactionReceipt{
+		Id:      &pb.TransactionId{Id: receipt.TxID.Bytes()},
+		Result:  result,
+		GasUsed: receipt.GasUsed,
+		Fee:     &pb.Amount{Value: receipt.Fee},
+		Layer:   &pb.LayerNumber{Number: uint32(receipt.Layer)},
+		Reason:  receipt.Reason,
+	}
+}
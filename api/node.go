@@ -5,6 +5,7 @@ import (
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/p2p/p2pcrypto"
+	"github.com/spacemeshos/go-spacemesh/signing"
 )
 
 // NetworkAPI is an API to nodes gossip network
@@ -47,15 +48,25 @@ type TxAPI interface {
 	GetTransactionsByOrigin(types.LayerID, types.Address) []types.TransactionID
 	LatestLayer() types.LayerID
 	GetLayerApplied(types.TransactionID) *types.LayerID
+	GetReceipt(types.TransactionID) (*types.Receipt, error)
 	GetTransaction(types.TransactionID) (*types.Transaction, error)
 	GetProjection(types.Address, uint64, uint64) (uint64, uint64, error)
 	LatestLayerInState() types.LayerID
+	PrunedLayer() types.LayerID
 	ProcessedLayer() types.LayerID
 	GetStateRoot() types.Hash32
 	GetLayerStateRoot(types.LayerID) (types.Hash32, error)
 	GetBalance(types.Address) uint64
 	GetNonce(types.Address) uint64
+	GetAccountControl(types.Address) *types.AccountControl
+	GetAccountAtLayer(types.Address, types.LayerID) (*types.AccountState, error)
+	GetAccountProof(types.Address, types.LayerID) (*types.AccountProof, error)
 	GetAllAccounts() (*types.MultipleAccountsState, error)
+	GetSupply(types.LayerID) (*types.Supply, error)
+	GetTransactionBlocks(types.TransactionID) ([]types.BlockID, error)
+	GetSmesherBlocks(*signing.PublicKey) ([]types.BlockID, error)
+	GetCoinbaseAtxIDs(types.Address) ([]types.ATXID, error)
+	GetBlock(types.BlockID) (*types.Block, error)
 	//TODO: fix the discrepancy between SmesherID and NodeID (see https://github.com/spacemeshos/go-spacemesh/issues/2269)
 	GetRewardsBySmesherID(types.NodeID) ([]types.Reward, error)
 }
@@ -71,3 +82,8 @@ type MempoolAPI interface {
 	GetTxIdsByAddress(types.Address) []types.TransactionID
 	GetProjection(types.Address, uint64, uint64) (uint64, uint64)
 }
+
+// TortoiseAPI is an API for inspecting the tortoise's view of layers
+type TortoiseAPI interface {
+	InspectLayer(types.LayerID) (*types.LayerInspection, error)
+}
@@ -183,6 +183,14 @@ func EnsureCLIFlags(cmd *cobra.Command, appCFG *bc.Config) error {
 			elem = reflect.ValueOf(&appCFG.POST).Elem()
 			assignFields(ff, elem, name)
 
+			ff = reflect.TypeOf(appCFG.TXPOOL)
+			elem = reflect.ValueOf(&appCFG.TXPOOL).Elem()
+			assignFields(ff, elem, name)
+
+			ff = reflect.TypeOf(appCFG.REWARD)
+			elem = reflect.ValueOf(&appCFG.REWARD).Elem()
+			assignFields(ff, elem, name)
+
 			ff = reflect.TypeOf(appCFG.LOGGING)
 			elem = reflect.ValueOf(&appCFG.LOGGING).Elem()
 			assignFields(ff, elem, name)
@@ -0,0 +1,102 @@
+package node
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spacemeshos/go-spacemesh/activation"
+	cmdp "github.com/spacemeshos/go-spacemesh/cmd"
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	cfg "github.com/spacemeshos/go-spacemesh/config"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+	"github.com/spacemeshos/go-spacemesh/state"
+)
+
+// nodeDatabases are the databases in the data folder of a node that isn't running, used by the commands that operate
+// on them directly.
+type nodeDatabases struct {
+	mdb       *mesh.DB
+	atxdb     *activation.DB
+	poetDb    *activation.PoetDb
+	processor *state.TransactionProcessor
+	closers   []func()
+}
+
+// offlineConfig parses the config of the node whose databases the command operates on.
+func offlineConfig(cmd *cobra.Command) (*cfg.Config, error) {
+	app := NewSpacemeshApp()
+	if err := app.ParseConfig(); err != nil {
+		log.Error(fmt.Sprintf("couldn't parse the config err=%v", err))
+	}
+	if err := cmdp.EnsureCLIFlags(cmd, app.Config); err != nil {
+		return nil, err
+	}
+	return app.Config, nil
+}
+
+// openDatabases opens the databases in the data folder the same way the node does.
+func openDatabases(conf *cfg.Config, lg log.Log) (*nodeDatabases, error) {
+	types.SetLayersPerEpoch(int32(conf.LayersPerEpoch))
+	dbStorepath := conf.DataDir()
+	dbs := &nodeDatabases{}
+	open := func(name string) (database.Database, error) {
+		db, err := database.NewPersistentDatabase(conf.DatabaseBackend, filepath.Join(dbStorepath, name), 0, 0, lg.WithName(name+"Db"))
+		if err != nil {
+			dbs.Close()
+			return nil, err
+		}
+		dbs.closers = append(dbs.closers, db.Close)
+		return db, nil
+	}
+
+	mdb, err := mesh.NewPersistentMeshDB(filepath.Join(dbStorepath, "mesh"), conf.DatabaseBackend, conf.BlockCacheSize, lg.WithName("meshDb"))
+	if err != nil {
+		return nil, err
+	}
+	dbs.closers = append(dbs.closers, mdb.Close)
+	mdb.ExplorerIndex = conf.ExplorerIndex
+	dbs.mdb = mdb
+
+	atxdbstore, err := open("atx")
+	if err != nil {
+		return nil, err
+	}
+	iddbstore, err := open("ids")
+	if err != nil {
+		return nil, err
+	}
+	goldenATXID := types.ATXID(types.HexToHash32(conf.GoldenATXID))
+	dbs.atxdb = activation.NewDB(atxdbstore, activation.NewIdentityStore(iddbstore), mdb, uint16(conf.LayersPerEpoch), goldenATXID, nil, lg.WithName("atxDb"))
+	dbs.atxdb.ExplorerIndex = conf.ExplorerIndex
+
+	poetDbStore, err := open("poet")
+	if err != nil {
+		return nil, err
+	}
+	dbs.poetDb = activation.NewPoetDb(poetDbStore, lg.WithName("poetDb"))
+
+	db, err := open("state")
+	if err != nil {
+		return nil, err
+	}
+	appliedTxs, err := open("appliedTxs")
+	if err != nil {
+		return nil, err
+	}
+	dbs.processor = state.NewTransactionProcessor(db, appliedTxs, nil, nil, lg.WithName("state"))
+	dbs.processor.StateHistory = conf.StateHistory
+	dbs.processor.StateCheckpointInterval = conf.StateCheckpointInterval
+	return dbs, nil
+}
+
+// Close closes the databases in the reverse order they were opened.
+func (dbs *nodeDatabases) Close() {
+	for i := len(dbs.closers) - 1; i >= 0; i-- {
+		dbs.closers[i]()
+	}
+	dbs.closers = nil
+}
@@ -121,6 +121,10 @@ func init() {
 	// TODO add commands actually adds flags
 	cmdp.AddCommands(Cmd)
 	Cmd.AddCommand(VersionCmd)
+	Cmd.AddCommand(VerifyCmd)
+	Cmd.AddCommand(ExportCmd)
+	Cmd.AddCommand(ImportCmd)
+	Cmd.AddCommand(ReplayCmd)
 }
 
 // Service is a general service interface that specifies the basic start/stop functionality
@@ -165,6 +169,7 @@ type SpacemeshApp struct {
 	oracle         *blocks.Oracle
 	txProcessor    *state.TransactionProcessor
 	mesh           *mesh.Mesh
+	tortoise       *tortoise.ThreadSafeVerifyingTortoise
 	gossipListener *service.Listener
 	clock          TickProvider
 	hare           HareService
@@ -356,6 +361,17 @@ func (app *SpacemeshApp) setupGenesis(state *state.TransactionProcessor, msh *me
 		state.CreateAccount(addr)
 		state.AddBalance(addr, acc.Balance)
 		state.SetNonce(addr, acc.Nonce)
+		if len(acc.Owners) > 0 {
+			control := types.AccountControl{Threshold: acc.Threshold, UnlockLayer: types.LayerID(acc.UnlockLayer)}
+			for _, owner := range acc.Owners {
+				control.Owners = append(control.Owners, types.HexToAddress(owner))
+			}
+			if err := control.Validate(); err != nil {
+				app.log.With().Error("cannot set control of genesis account", log.String("acct_id", id), log.Err(err))
+				continue
+			}
+			state.SetAccountControl(addr, control)
+		}
 		app.log.With().Info("genesis account created",
 			log.String("acct_id", id),
 			log.Uint64("balance", acc.Balance))
@@ -481,7 +497,7 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 
 	postClient.SetLogger(app.addLogger(PostLogger, lg))
 
-	db, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "state"), 0, 0, app.addLogger(StateDbLogger, lg))
+	db, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "state"), 0, 0, app.addLogger(StateDbLogger, lg))
 	if err != nil {
 		return err
 	}
@@ -489,25 +505,25 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 
 	coinToss := weakCoinStub{}
 
-	atxdbstore, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "atx"), 0, 0, app.addLogger(AtxDbStoreLogger, lg))
+	atxdbstore, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "atx"), 0, 0, app.addLogger(AtxDbStoreLogger, lg))
 	if err != nil {
 		return err
 	}
 	app.closers = append(app.closers, atxdbstore)
 
-	poetDbStore, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "poet"), 0, 0, app.addLogger(PoetDbStoreLogger, lg))
+	poetDbStore, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "poet"), 0, 0, app.addLogger(PoetDbStoreLogger, lg))
 	if err != nil {
 		return err
 	}
 	app.closers = append(app.closers, poetDbStore)
 
-	iddbstore, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "ids"), 0, 0, app.addLogger(StateDbLogger, lg))
+	iddbstore, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "ids"), 0, 0, app.addLogger(StateDbLogger, lg))
 	if err != nil {
 		return err
 	}
 	app.closers = append(app.closers, iddbstore)
 
-	store, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "store"), 0, 0, app.addLogger(StoreLogger, lg))
+	store, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "store"), 0, 0, app.addLogger(StoreLogger, lg))
 	if err != nil {
 		return err
 	}
@@ -516,20 +532,49 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 	idStore := activation.NewIdentityStore(iddbstore)
 	poetDb := activation.NewPoetDb(poetDbStore, app.addLogger(PoetDbLogger, lg))
 	validator := activation.NewValidator(&app.Config.POST, poetDb)
-	mdb, err := mesh.NewPersistentMeshDB(filepath.Join(dbStorepath, "mesh"), app.Config.BlockCacheSize, app.addLogger(MeshDBLogger, lg))
+	if app.Config.LayerRetention != 0 && int(app.Config.LayerRetention) <= app.Config.Hdist {
+		return fmt.Errorf("layer retention (%d) must be greater than hdist (%d)", app.Config.LayerRetention, app.Config.Hdist)
+	}
+	if app.Config.StateHistory != 0 {
+		if app.Config.StateCheckpointInterval == 0 || app.Config.StateCheckpointInterval > app.Config.StateHistory {
+			return fmt.Errorf("state checkpoint interval (%d) must be between 1 and the state history (%d)", app.Config.StateCheckpointInterval, app.Config.StateHistory)
+		}
+		// after a crash, the layers after the latest checkpoint are applied again, so their blocks must be kept
+		if app.Config.LayerRetention != 0 && app.Config.LayerRetention <= app.Config.StateCheckpointInterval {
+			return fmt.Errorf("layer retention (%d) must be greater than the state checkpoint interval (%d)", app.Config.LayerRetention, app.Config.StateCheckpointInterval)
+		}
+	}
+	mdb, err := mesh.NewPersistentMeshDB(filepath.Join(dbStorepath, "mesh"), app.Config.DatabaseBackend, app.Config.BlockCacheSize, app.addLogger(MeshDBLogger, lg))
 	if err != nil {
 		return err
 	}
+	mdb.LayerRetention = app.Config.LayerRetention
+	mdb.ExplorerIndex = app.Config.ExplorerIndex
+	if err := mdb.BuildExplorerIndex(); err != nil {
+		return fmt.Errorf("failed to build explorer index of blocks: %v", err)
+	}
+
+	mempoolStore, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "mempool"), 0, 0, lg.WithName("mempool"))
+	if err != nil {
+		return err
+	}
+	app.closers = append(app.closers, mempoolStore)
 
-	app.txPool = state.NewTxMemPool()
+	app.txPool, err = state.NewPersistentTxMemPool(app.Config.TXPOOL, mempoolStore)
+	if err != nil {
+		return err
+	}
 	meshAndPoolProjector := pendingtxs.NewMeshAndPoolProjector(mdb, app.txPool)
 
-	appliedTxs, err := database.NewLDBDatabase(filepath.Join(dbStorepath, "appliedTxs"), 0, 0, lg.WithName("appliedTxs"))
+	appliedTxs, err := database.NewPersistentDatabase(app.Config.DatabaseBackend, filepath.Join(dbStorepath, "appliedTxs"), 0, 0, lg.WithName("appliedTxs"))
 	if err != nil {
 		return err
 	}
 	app.closers = append(app.closers, appliedTxs)
 	processor := state.NewTransactionProcessor(db, appliedTxs, meshAndPoolProjector, app.txPool, lg.WithName("state"))
+	processor.StateHistory = app.Config.StateHistory
+	processor.StateCheckpointInterval = app.Config.StateCheckpointInterval
+	processor.StateCacheLimit = types.StorageSize(app.Config.StateCacheSize * 1024 * 1024)
 
 	goldenATXID := types.ATXID(types.HexToHash32(app.Config.GoldenATXID))
 	if goldenATXID == *types.EmptyATXID {
@@ -537,8 +582,16 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 	}
 
 	atxdb := activation.NewDB(atxdbstore, idStore, mdb, layersPerEpoch, goldenATXID, validator, app.addLogger(AtxDbLogger, lg))
+	atxdb.ExplorerIndex = app.Config.ExplorerIndex
+	if err := atxdb.BuildExplorerIndex(); err != nil {
+		return fmt.Errorf("failed to build explorer index of atxs: %v", err)
+	}
 	beaconProvider := &blocks.EpochBeaconProvider{}
 
+	if err := app.Config.REWARD.Validate(); err != nil {
+		return err
+	}
+
 	var msh *mesh.Mesh
 	var trtl *tortoise.ThreadSafeVerifyingTortoise
 	trtlCfg := tortoise.Config{
@@ -547,6 +600,9 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 		Hdist:     app.Config.Hdist,
 		Log:       app.addLogger(TrtlLogger, lg),
 		Recovered: mdb.PersistentData(),
+
+		FullTortoiseDistance: app.Config.FullTortoiseDistance,
+		AtxDB:                atxdb,
 	}
 
 	trtl = tortoise.NewVerifyingTortoise(trtlCfg)
@@ -559,6 +615,10 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 		app.setupGenesis(processor, msh)
 	}
 
+	if err := processor.RestoreTxPool(); err != nil {
+		return fmt.Errorf("failed to restore mempool: %v", err)
+	}
+
 	eValidator := blocks.NewBlockEligibilityValidator(layerSize, app.Config.GenesisTotalWeight, layersPerEpoch, atxdb, beaconProvider, BLS381.Verify2, msh, app.addLogger(BlkEligibilityLogger, lg))
 
 	syncConf := sync.Configuration{Concurrency: 4,
@@ -645,6 +705,7 @@ func (app *SpacemeshApp) initServices(nodeID types.NodeID,
 	app.blockListener = blockListener
 	app.gossipListener = gossipListener
 	app.mesh = msh
+	app.tortoise = trtl
 	app.syncer = syncer
 	app.clock = clock
 	app.state = processor
@@ -759,7 +820,7 @@ func (app *SpacemeshApp) startAPIServices(net api.NetworkAPI) {
 
 	// Register the requested services one by one
 	if apiConf.StartDebugService {
-		registerService(grpcserver.NewDebugService(app.mesh))
+		registerService(grpcserver.NewDebugService(app.mesh, app.tortoise))
 	}
 	if apiConf.StartGatewayService {
 		registerService(grpcserver.NewGatewayService(net))
@@ -864,6 +925,13 @@ func (app *SpacemeshApp) stopServices() {
 		app.syncer.Close()
 	}
 
+	if app.txProcessor != nil {
+		app.log.Info("%v persisting state", app.nodeID.Key)
+		if err := app.txProcessor.PersistState(); err != nil {
+			log.Error("cannot persist state %v", err)
+		}
+	}
+
 	if app.mesh != nil {
 		app.log.Info("%v closing mesh", app.nodeID.Key)
 		app.mesh.Close()
@@ -816,7 +816,7 @@ func TestSpacemeshApp_TransactionService(t *testing.T) {
 	dst := types.BytesToAddress([]byte{0x02})
 	tx, err := types.NewSignedTx(0, dst, 10, 1, 1, signer)
 	require.NoError(t, err, "unable to create signed mock tx")
-	txbytes, _ := types.InterfaceToBytes(tx)
+	txbytes, _ := types.TransactionToBytes(tx)
 
 	// Coordinate ending the test
 	wg2 := sync.WaitGroup{}
@@ -0,0 +1,96 @@
+package node
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spacemeshos/go-spacemesh/activation"
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	cfg "github.com/spacemeshos/go-spacemesh/config"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+	"github.com/spacemeshos/go-spacemesh/tortoise"
+)
+
+// ReplayCmd replays the mesh of a node that isn't running into a new tortoise, and compares the blocks' validity
+var ReplayCmd = &cobra.Command{
+	Use:   "replay-tortoise",
+	Short: "Replay the node's mesh into a new tortoise and compare the blocks' validity",
+	Long: `Opens the mesh and activation databases in the data folder of a node that isn't running read-only, and feeds
+the layers of the mesh up to --to, with their input vectors, to a new verifying tortoise in memory. The tortoise uses
+the --hdist, --layer-average-size and --full-tortoise-distance of the config, which can be overridden to try other
+parameters. The validity the tortoise assigns to the blocks of layers --from to --to is then compared with the validity
+stored in the mesh, and the blocks whose validity differs are reported. The databases aren't changed. A mesh whose old
+layers were pruned, with --layer-retention, can't be replayed.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conf, err := offlineConfig(cmd)
+		if err != nil {
+			return err
+		}
+		res, err := replayTortoise(conf, types.LayerID(replayFrom), types.LayerID(replayTo), log.NewDefault("replay"))
+		if err != nil {
+			return err
+		}
+		for _, diff := range res.Diffs {
+			fmt.Println(diff)
+		}
+		fmt.Printf("replayed tortoise verified layer %v, %d of %d compared blocks differ\n",
+			res.Verified, len(res.Diffs), res.Compared)
+		if len(res.Diffs) > 0 {
+			return fmt.Errorf("the validity of %d blocks differs", len(res.Diffs))
+		}
+		return nil
+	},
+}
+
+var replayFrom, replayTo uint64
+
+func init() {
+	ReplayCmd.Flags().Uint64Var(&replayFrom, "from", 0,
+		"the first layer whose blocks' validity is compared, the first layer after genesis if 0")
+	ReplayCmd.Flags().Uint64Var(&replayTo, "to", 0,
+		"the last layer that is replayed, the last layer the node's tortoise handled if 0")
+}
+
+// replayTortoise opens the mesh and activation databases in the data folder read-only, and replays the mesh into a new
+// tortoise with the config's parameters.
+func replayTortoise(conf *cfg.Config, from, to types.LayerID, lg log.Log) (*tortoise.ReplayResult, error) {
+	types.SetLayersPerEpoch(int32(conf.LayersPerEpoch))
+	dbStorepath := conf.DataDir()
+	mdb, err := mesh.NewReadOnlyMeshDB(filepath.Join(dbStorepath, "mesh"), conf.BlockCacheSize, lg.WithName("meshDb"))
+	if err != nil {
+		return nil, err
+	}
+	defer mdb.Close()
+	atxdbstore, err := database.NewReadOnlyDatabase(filepath.Join(dbStorepath, "atx"), lg.WithName("atxDb"))
+	if err != nil {
+		return nil, err
+	}
+	defer atxdbstore.Close()
+	iddbstore, err := database.NewReadOnlyDatabase(filepath.Join(dbStorepath, "ids"), lg.WithName("idsDb"))
+	if err != nil {
+		return nil, err
+	}
+	defer iddbstore.Close()
+	goldenATXID := types.ATXID(types.HexToHash32(conf.GoldenATXID))
+	atxdb := activation.NewDB(atxdbstore, activation.NewIdentityStore(iddbstore), mdb, uint16(conf.LayersPerEpoch), goldenATXID, nil, lg.WithName("atxDb"))
+
+	if to == 0 {
+		if to, err = mdb.StoredProcessedLayer(); err != nil {
+			return nil, fmt.Errorf("can't find the last layer the tortoise handled: %v", err)
+		}
+	}
+	return tortoise.Replay(mdb, tortoise.ReplayConfig{
+		From:                 from,
+		To:                   to,
+		Hdist:                conf.Hdist,
+		AvgLayerSize:         conf.LayerAvgSize,
+		FullTortoiseDistance: conf.FullTortoiseDistance,
+		AtxDB:                atxdb,
+		Log:                  lg.WithName("trtl"),
+	})
+}
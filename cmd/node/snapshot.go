@@ -0,0 +1,167 @@
+package node
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	cfg "github.com/spacemeshos/go-spacemesh/config"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/snapshot"
+)
+
+// ExportCmd writes a snapshot of the databases of a node that isn't running
+var ExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Export a snapshot of the node's databases",
+	Long: `Writes a snapshot of the databases in the data folder of a node that isn't running to a file: the state at the
+latest layer in state, the blocks and transactions of the latest layers, all the ATXs and the PoET proofs they reference.
+A new node can be bootstrapped from the snapshot with the import command instead of syncing from genesis.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conf, err := offlineConfig(cmd)
+		if err != nil {
+			return err
+		}
+		header, err := exportSnapshot(conf, args[0], exportLayers, log.NewDefault("export"))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("exported state of layer %v with root %v and layers %v to %v\n",
+			header.Layer, header.StateRoot.Hex(), header.FirstLayer, header.LastLayer)
+		return nil
+	},
+}
+
+// ImportCmd bootstraps the databases of a new node from a snapshot
+var ImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Bootstrap the node's databases from a snapshot",
+	Long: `Reads a snapshot written by the export command into the empty data folder of a node that isn't running. The
+snapshot must have the state root given with --state-root, which should be obtained from a trusted source, e.g. a node
+that is synced, since the snapshot can't vouch for itself. The snapshot's checksum and state root are checked before
+anything is written, and its state against its state root. If the import fails, what it wrote to the data folder is
+removed.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := parseStateRoot(importStateRoot)
+		if err != nil {
+			return err
+		}
+		conf, err := offlineConfig(cmd)
+		if err != nil {
+			return err
+		}
+		header, err := importSnapshot(conf, args[0], root, log.NewDefault("import"))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("imported state of layer %v with root %v and layers %v to %v\n",
+			header.Layer, header.StateRoot.ShortString(), header.FirstLayer, header.LastLayer)
+		return nil
+	},
+}
+
+var (
+	exportLayers    uint32
+	importStateRoot string
+)
+
+func init() {
+	ExportCmd.Flags().Uint32Var(&exportLayers, "layers", 1000,
+		"number of layers up to the latest layer in state whose blocks are exported, 0 for all layers")
+	ImportCmd.Flags().StringVar(&importStateRoot, "state-root", "",
+		"the hex encoded state root the snapshot must have, as printed by the export command or reported by a synced node")
+	if err := ImportCmd.MarkFlagRequired("state-root"); err != nil {
+		panic(err)
+	}
+}
+
+// parseStateRoot parses a hex encoded state root, with or without the 0x prefix.
+func parseStateRoot(s string) (types.Hash32, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil || len(b) != types.Hash32Length {
+		return types.Hash32{}, fmt.Errorf("invalid state root %q: expected %d hex encoded bytes", s, types.Hash32Length)
+	}
+	return types.BytesToHash(b), nil
+}
+
+// exportSnapshot writes a snapshot of the databases in the data folder to the file at path.
+func exportSnapshot(conf *cfg.Config, path string, layers uint32, lg log.Log) (*snapshot.Header, error) {
+	dbs, err := openDatabases(conf, lg)
+	if err != nil {
+		return nil, err
+	}
+	defer dbs.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	header, err := snapshot.Export(w, dbs.mdb, dbs.atxdb, dbs.poetDb, dbs.processor, layers)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	return header, f.Close()
+}
+
+// importSnapshot reads the snapshot in the file at path, which must have the given state root, into the databases in the
+// data folder. If it fails, the files and folders it created in the data folder are removed.
+func importSnapshot(conf *cfg.Config, path string, root types.Hash32, lg log.Log) (header *snapshot.Header, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dataDir := conf.DataDir()
+	existing := make(map[string]bool)
+	if entries, err := ioutil.ReadDir(dataDir); err == nil {
+		for _, entry := range entries {
+			existing[entry.Name()] = true
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		entries, readErr := ioutil.ReadDir(dataDir)
+		if readErr != nil {
+			lg.With().Error("failed to clean up the data folder after the failed import", log.Err(readErr))
+			return
+		}
+		for _, entry := range entries {
+			if existing[entry.Name()] {
+				continue
+			}
+			if rmErr := os.RemoveAll(filepath.Join(dataDir, entry.Name())); rmErr != nil {
+				lg.With().Error("failed to clean up the data folder after the failed import", log.Err(rmErr))
+			}
+		}
+	}()
+
+	dbs, err := openDatabases(conf, lg)
+	if err != nil {
+		return nil, err
+	}
+	// the databases are closed before what they wrote is removed
+	defer dbs.Close()
+	return snapshot.Import(f, root, dbs.mdb, dbs.atxdb, dbs.poetDb, dbs.processor)
+}
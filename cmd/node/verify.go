@@ -0,0 +1,73 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/spacemeshos/go-spacemesh/config"
+	"github.com/spacemeshos/go-spacemesh/log"
+)
+
+// VerifyCmd checks that the databases of a node that isn't running agree with each other
+var VerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check the node's databases for inconsistencies",
+	Long: `Walks the mesh, state and activation databases in the data folder of a node that isn't running, and reports the
+inconsistencies between them: blocks missing from their layers or layers listing missing blocks, running layer hashes
+that don't follow from the layers' blocks, state roots that are missing or whose tries can't be read and ATXs
+referencing missing ATXs. With --repair, the data derived from the blocks and ATXs (the layers' block IDs, the running layer hashes and the
+secondary indexes) is rebuilt.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conf, err := offlineConfig(cmd)
+		if err != nil {
+			return err
+		}
+		problems, err := verifyDatabases(conf, verifyRepair, log.NewDefault("verify"))
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if err != nil {
+			return err
+		}
+		if len(problems) > 0 {
+			if verifyRepair {
+				return fmt.Errorf("found %d inconsistencies, derived data was rebuilt where possible", len(problems))
+			}
+			return fmt.Errorf("found %d inconsistencies", len(problems))
+		}
+		fmt.Println("no inconsistencies found")
+		return nil
+	},
+}
+
+var verifyRepair bool
+
+func init() {
+	VerifyCmd.Flags().BoolVar(&verifyRepair, "repair", false,
+		"rebuild the layers' block IDs, running layer hashes and secondary indexes from the blocks and ATXs")
+}
+
+// verifyDatabases opens the databases in the data folder the same way the node does, and returns the inconsistencies
+// found in them.
+func verifyDatabases(conf *cfg.Config, repair bool, lg log.Log) (problems []error, err error) {
+	dbs, err := openDatabases(conf, lg)
+	if err != nil {
+		return nil, err
+	}
+	defer dbs.Close()
+
+	found, err := dbs.mdb.Verify(repair, dbs.atxdb.VerifyBlockReferences)
+	problems = append(problems, found...)
+	if err != nil {
+		return problems, err
+	}
+	found, err = dbs.atxdb.Verify(repair)
+	problems = append(problems, found...)
+	if err != nil {
+		return problems, err
+	}
+	found, err = dbs.processor.VerifyStateRoots()
+	return append(problems, found...), err
+}
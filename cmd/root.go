@@ -42,6 +42,8 @@ func AddCommands(cmd *cobra.Command) {
 		config.LayerAvgSize, "Layer Avg size")
 	cmd.PersistentFlags().IntVar(&config.Hdist, "hdist",
 		config.Hdist, "hdist")
+	cmd.PersistentFlags().IntVar(&config.FullTortoiseDistance, "full-tortoise-distance",
+		config.FullTortoiseDistance, "number of layers a layer must be behind the last layer before the full tortoise verifies it if the verifying tortoise can't (0 to disable the full tortoise)")
 	cmd.PersistentFlags().BoolVar(&config.StartMining, "start-mining",
 		config.StartMining, "start mining")
 	cmd.PersistentFlags().StringVar(&config.MemProfile, "mem-profile",
@@ -62,6 +64,18 @@ func AddCommands(cmd *cobra.Command) {
 		config.GenesisTotalWeight, "The active set size for the genesis flow")
 	cmd.PersistentFlags().IntVar(&config.BlockCacheSize, "block-cache-size",
 		config.BlockCacheSize, "size in layers of meshdb block cache")
+	cmd.PersistentFlags().Uint32Var(&config.LayerRetention, "layer-retention",
+		config.LayerRetention, "number of layers before the latest layer in state whose blocks and transactions are kept, older ones are pruned (0 to keep all layers)")
+	cmd.PersistentFlags().Uint32Var(&config.StateHistory, "state-history",
+		config.StateHistory, "number of latest layers whose state is kept, older states are garbage collected (0 to keep the states of all layers)")
+	cmd.PersistentFlags().Uint32Var(&config.StateCheckpointInterval, "state-checkpoint-interval",
+		config.StateCheckpointInterval, "number of layers between checkpoints, whose states are persisted to recover from when state-history is set (required with state-history)")
+	cmd.PersistentFlags().IntVar(&config.StateCacheSize, "state-cache-size",
+		config.StateCacheSize, "memory in MB that the states kept by state-history may take before they're persisted (0 for no limit)")
+	cmd.PersistentFlags().BoolVar(&config.ExplorerIndex, "explorer-index",
+		config.ExplorerIndex, "index blocks by the transactions they include and by smesher, and ATXs by coinbase, to serve explorer queries")
+	cmd.PersistentFlags().StringVar(&config.DatabaseBackend, "db-backend",
+		config.DatabaseBackend, "backend of the node's databases: leveldb (the default) or badger. Existing databases aren't converted")
 	cmd.PersistentFlags().StringVar(&config.PublishEventsURL, "events-url",
 		config.PublishEventsURL, "publish events to this url; if no url specified no events will be published")
 	cmd.PersistentFlags().BoolVar(&config.Profiler, "profiler",
@@ -200,6 +214,26 @@ func AddCommands(cmd *cobra.Command) {
 	cmd.PersistentFlags().UintVar(&config.POST.MaxReadFilesParallelism, "post-parallel-read",
 		config.POST.MaxReadFilesParallelism, "Max degree of files read parallelism")
 
+	/**======================== Mempool Flags ========================== **/
+
+	cmd.PersistentFlags().StringVar(&config.TXPOOL.SelectionPolicy, "tx-selection-policy",
+		config.TXPOOL.SelectionPolicy, "how to select transactions for new blocks: \"fee\" (highest fee per gas first) or \"random\"")
+	cmd.PersistentFlags().IntVar(&config.TXPOOL.MaxTxs, "tx-pool-max-txs",
+		config.TXPOOL.MaxTxs, "the maximum number of transactions kept in the mempool (0 for no limit)")
+	cmd.PersistentFlags().IntVar(&config.TXPOOL.MaxTxsPerAccount, "tx-pool-max-txs-per-account",
+		config.TXPOOL.MaxTxsPerAccount, "the maximum number of transactions from a single account kept in the mempool (0 for no limit)")
+	cmd.PersistentFlags().Uint64Var(&config.TXPOOL.ReplaceFeeBump, "tx-replace-fee-bump",
+		config.TXPOOL.ReplaceFeeBump, "the minimum fee increase, in percent, for a transaction to replace a pending transaction with the same nonce")
+
+	/**======================== Reward Flags ========================== **/
+
+	cmd.PersistentFlags().Uint64Var(&config.REWARD.EpochDecay, "epoch-decay",
+		config.REWARD.EpochDecay, "the fraction, in parts per million, by which the layer reward decreases each epoch")
+	cmd.PersistentFlags().Uint64Var(&config.REWARD.HalvingInterval, "halving-interval",
+		config.REWARD.HalvingInterval, "the number of epochs after which the layer reward halves (0 for no halvings)")
+	cmd.PersistentFlags().StringVar(&config.REWARD.RemainderPolicy, "reward-remainder-policy",
+		config.REWARD.RemainderPolicy, "how to distribute the part of a layer's reward that can't be split evenly among its blocks: \"burn\" or \"round-robin\"")
+
 	/**========================Consensus Flags ========================== **/
 
 	cmd.PersistentFlags().IntVar(&config.LayersPerEpoch, "layers-per-epoch",
@@ -134,7 +134,7 @@ func (app *syncApp) start(cmd *cobra.Command, args []string) {
 
 	poetDb := activation.NewPoetDb(poetDbStore, lg.WithName("poetDb").WithOptions(log.Nop))
 
-	mshdb, err := mesh.NewPersistentMeshDB(filepath.Join(path, "mesh"), 5, lg.WithOptions(log.Nop))
+	mshdb, err := mesh.NewPersistentMeshDB(filepath.Join(path, "mesh"), database.LevelDBBackend, 5, lg.WithOptions(log.Nop))
 	if err != nil {
 		lg.With().Error("error creating mesh database", log.Err(err))
 		return
@@ -1,11 +1,52 @@
 package types
 
+import "fmt"
+
 // AccountState struct represents basic account data: nonce and balance
 // Todo: get rid of big.Int everywhere and replace with uint64
 // See https://github.com/spacemeshos/go-spacemesh/issues/2192
 type AccountState struct {
 	Nonce   uint64 `json:"nonce"`
 	Balance uint64 `json:"balance"`
+	// Control is empty for simple accounts, which are spent by the key their address is derived from. Multisig and vault
+	// accounts hold a single AccountControl, and can only be spent by TxTypeSpend transactions that satisfy it. It's
+	// encoded as the tail of the account, so simple accounts encode exactly the same as before it was added.
+	Control []AccountControl `json:"control,omitempty" rlp:"tail"`
+}
+
+// AccountControl defines who may spend from a multisig or vault account, and from which layer.
+type AccountControl struct {
+	Threshold   uint64    `json:"threshold"`    // number of owners that must sign a spend
+	Owners      []Address `json:"owners"`       // addresses of the owners' keys
+	UnlockLayer LayerID   `json:"unlock_layer"` // vaults can't be spent before this layer, 0 for multisig accounts
+}
+
+// IsVault returns true if the account is time-locked.
+func (c *AccountControl) IsVault() bool {
+	return c.UnlockLayer > 0
+}
+
+// Validate returns an error if the control can never be satisfied or lists an owner more than once.
+func (c *AccountControl) Validate() error {
+	if c.Threshold == 0 || c.Threshold > uint64(len(c.Owners)) {
+		return fmt.Errorf("threshold must be between 1 and the number of owners (%d), got %d", len(c.Owners), c.Threshold)
+	}
+	seen := make(map[Address]struct{}, len(c.Owners))
+	for _, owner := range c.Owners {
+		if _, ok := seen[owner]; ok {
+			return fmt.Errorf("owner %v is listed more than once", owner.Short())
+		}
+		seen[owner] = struct{}{}
+	}
+	return nil
+}
+
+// AccountProof is an account's state at a layer, along with a Merkle proof of it against the layer's state root.
+type AccountProof struct {
+	Layer   LayerID
+	Root    Hash32
+	Account AccountState
+	Nodes   [][]byte // the encoded state trie nodes on the path to the account, starting with the root
 }
 
 // MultipleAccountsState is a struct used to dump an entire state root
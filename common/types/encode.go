@@ -2,8 +2,10 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 
 	xdr "github.com/nullstyle/go-xdr/xdr3"
 	"github.com/spacemeshos/go-spacemesh/common/util"
@@ -54,14 +56,158 @@ func NIPSTChallengeToBytes(challenge *NIPSTChallenge) ([]byte, error) {
 	return w.Bytes(), nil
 }
 
+// typedTxMarker starts the encoding of transactions of every type but TxTypeTransfer. Transfers start with their
+// nonce, whose high 32 bits never reach it, so both kinds can be told apart when decoding.
+const typedTxMarker = ^uint32(0)
+
+// transferTransaction is the encoding of TxTypeTransfer transactions.
+type transferTransaction struct {
+	InnerTransaction
+	Signature [64]byte
+}
+
+// typedInnerTransaction is the part of a typed transaction that its signatures sign.
+type typedInnerTransaction struct {
+	Marker  uint32
+	Type    TxType
+	Inner   InnerTransaction
+	Account Address
+	Batch   []Transfer
+	Control []AccountControl // the Control of a TxTypeCreateAccount, empty for the other types
+}
+
+// typedTransaction is the envelope of transactions of every type but TxTypeTransfer.
+type typedTransaction struct {
+	Inner        typedInnerTransaction
+	Signature    [64]byte
+	CoSignatures [][64]byte
+}
+
+func (t *Transaction) typedInner() *typedInnerTransaction {
+	inner := &typedInnerTransaction{
+		Marker:  typedTxMarker,
+		Type:    t.Type,
+		Inner:   t.InnerTransaction,
+		Account: t.Account,
+		Batch:   t.Batch,
+	}
+	if t.Control != nil {
+		inner.Control = []AccountControl{*t.Control}
+	}
+	return inner
+}
+
+// SignedBytes returns the bytes that the transaction's signatures sign: the encoding of its InnerTransaction for
+// transfers, and the encoding of its typed envelope without the signatures for the other types.
+func (t *Transaction) SignedBytes() ([]byte, error) {
+	if t.Type == TxTypeTransfer {
+		return InterfaceToBytes(&t.InnerTransaction)
+	}
+	return InterfaceToBytes(t.typedInner())
+}
+
+// TransactionToBytes serializes a Transaction. Transfers are encoded as their InnerTransaction and Signature, and the
+// other types in a typed envelope that starts with typedTxMarker.
+func TransactionToBytes(tx *Transaction) ([]byte, error) {
+	if tx.Type != TxTypeTransfer {
+		return InterfaceToBytes(&typedTransaction{Inner: *tx.typedInner(), Signature: tx.Signature, CoSignatures: tx.CoSignatures})
+	}
+	if uint32(tx.AccountNonce>>32) == typedTxMarker {
+		return nil, fmt.Errorf("transfer nonce %d is out of range", tx.AccountNonce)
+	}
+	return InterfaceToBytes(&transferTransaction{InnerTransaction: tx.InnerTransaction, Signature: tx.Signature})
+}
+
 // BytesToTransaction deserializes a Transaction.
 func BytesToTransaction(buf []byte) (*Transaction, error) {
-	b := Transaction{}
-	_, err := xdr.Unmarshal(bytes.NewReader(buf), &b)
-	if err != nil {
+	return DecodeTransaction(bytes.NewReader(buf))
+}
+
+// DecodeTransaction reads a Transaction encoded by TransactionToBytes from r, leaving whatever follows it, so it can be
+// embedded in other encodings.
+func DecodeTransaction(r io.Reader) (*Transaction, error) {
+	var marker [4]byte
+	if _, err := io.ReadFull(r, marker[:]); err != nil {
 		return nil, err
 	}
-	return &b, nil
+	r = io.MultiReader(bytes.NewReader(marker[:]), r)
+	if binary.BigEndian.Uint32(marker[:]) != typedTxMarker {
+		var tx transferTransaction
+		if _, err := xdr.Unmarshal(r, &tx); err != nil {
+			return nil, err
+		}
+		return &Transaction{InnerTransaction: tx.InnerTransaction, Signature: tx.Signature}, nil
+	}
+	var tx typedTransaction
+	if _, err := xdr.Unmarshal(r, &tx); err != nil {
+		return nil, err
+	}
+	if tx.Inner.Type == TxTypeTransfer {
+		return nil, errors.New("transfers can't be encoded as typed transactions")
+	}
+	if len(tx.Inner.Control) > 1 {
+		return nil, errors.New("transaction has more than one control")
+	}
+	decoded := &Transaction{
+		InnerTransaction: tx.Inner.Inner,
+		Signature:        tx.Signature,
+		Type:             tx.Inner.Type,
+		Account:          tx.Inner.Account,
+		Batch:            tx.Inner.Batch,
+		CoSignatures:     tx.CoSignatures,
+	}
+	if len(tx.Inner.Control) == 1 {
+		decoded.Control = &tx.Inner.Control[0]
+	}
+	return decoded, nil
+}
+
+// TransactionsToBytes serializes a slice of Transactions. A slice of transfers is encoded exactly like InterfaceToBytes
+// encodes it: its length, followed by each transaction as XDR optional data.
+func TransactionsToBytes(txs []*Transaction) ([]byte, error) {
+	var w bytes.Buffer
+	if _, err := xdr.Marshal(&w, uint32(len(txs))); err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		txBytes, err := TransactionToBytes(tx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := xdr.Marshal(&w, true); err != nil {
+			return nil, err
+		}
+		w.Write(txBytes)
+	}
+	return w.Bytes(), nil
+}
+
+// BytesToTransactions deserializes a slice of Transactions encoded by TransactionsToBytes.
+func BytesToTransactions(buf []byte) ([]*Transaction, error) {
+	r := bytes.NewReader(buf)
+	var n uint32
+	if _, err := xdr.Unmarshal(r, &n); err != nil {
+		return nil, err
+	}
+	if uint64(n) > uint64(r.Len()) {
+		return nil, fmt.Errorf("too many transactions: %d", n)
+	}
+	var txs []*Transaction
+	for i := uint32(0); i < n; i++ {
+		var present bool
+		if _, err := xdr.Unmarshal(r, &present); err != nil {
+			return nil, err
+		}
+		if !present {
+			return nil, errors.New("missing transaction")
+		}
+		tx, err := DecodeTransaction(r)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
 }
 
 // BytesToInterface deserializes any type.
@@ -0,0 +1,57 @@
+package types
+
+import "math/bits"
+
+// Gas schedule. A transaction pays for the gas it uses at its GasPrice, so its fee is the gas used times the price,
+// and must not exceed the GasLimit times the price.
+const (
+	// GasTransfer is the gas used by a simple transfer: verifying the signature, updating the origin's nonce and moving
+	// the amount and the fee between accounts. A batch uses it once for each of its transfers.
+	GasTransfer uint64 = 1
+	// GasCoSignature is the additional gas used to verify each co-signature of a spend from a multisig account.
+	GasCoSignature uint64 = 1
+	// GasCreateAccount is the additional gas used by an account creation to store the created account's control.
+	GasCreateAccount uint64 = 1
+)
+
+// IntrinsicGas returns the gas used by applying the transaction, which depends only on its type and content. A
+// transaction with a lower GasLimit can never be applied.
+func (t *Transaction) IntrinsicGas() uint64 {
+	transfers := uint64(1)
+	if t.Type == TxTypeBatch {
+		transfers = uint64(len(t.Batch))
+	}
+	gas := GasTransfer*transfers + GasCoSignature*uint64(len(t.CoSignatures))
+	if t.Type == TxTypeCreateAccount {
+		gas += GasCreateAccount
+	}
+	return gas
+}
+
+// GasPrice returns the fee the transaction pays per unit of gas it uses, which is set in its Fee field. Transactions
+// compete for inclusion in blocks by their gas price.
+func (t *Transaction) GasPrice() uint64 {
+	return t.Fee
+}
+
+// GasFee returns the fee paid for using the given amount of gas at the transaction's gas price. ok is false if the fee
+// doesn't fit in 64 bits.
+func (t *Transaction) GasFee(gas uint64) (fee uint64, ok bool) {
+	hi, lo := bits.Mul64(gas, t.GasPrice())
+	return lo, hi == 0
+}
+
+// TotalCost returns the amount the transaction deducts from its origin's balance when it's applied: the total
+// transferred amount plus the fee for its intrinsic gas. ok is false if the cost doesn't fit in 64 bits.
+func (t *Transaction) TotalCost() (cost uint64, ok bool) {
+	fee, ok := t.GasFee(t.IntrinsicGas())
+	if !ok {
+		return 0, false
+	}
+	amount, ok := t.TotalAmount()
+	if !ok {
+		return 0, false
+	}
+	cost, carry := bits.Add64(amount, fee, 0)
+	return cost, carry == 0
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_TotalCost(t *testing.T) {
+	r := require.New(t)
+
+	tx := &Transaction{InnerTransaction: InnerTransaction{GasLimit: 100, Fee: 3, Amount: 10}}
+	fee, ok := tx.GasFee(tx.IntrinsicGas())
+	r.True(ok)
+	r.Equal(3*GasTransfer, fee)
+	cost, ok := tx.TotalCost()
+	r.True(ok)
+	r.Equal(10+3*GasTransfer, cost)
+
+	tx.Fee = ^uint64(0)
+	_, ok = tx.GasFee(2)
+	r.False(ok)
+
+	tx.Fee, tx.Amount = 1, ^uint64(0)
+	_, ok = tx.TotalCost()
+	r.False(ok)
+}
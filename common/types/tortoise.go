@@ -0,0 +1,37 @@
+package types
+
+// VoteTally is an amount of votes for and against a block.
+type VoteTally struct {
+	Support int
+	Against int
+}
+
+// BlockVote is the opinion of a block of a later layer on a block of an inspected layer.
+type BlockVote struct {
+	Voter  BlockID
+	Layer  LayerID
+	Good   bool // the votes of good blocks are counted by the verifying tortoise
+	Weight int
+	Vote   VoteTally
+}
+
+// BlockInspection is the tortoise's view of a block of an inspected layer.
+type BlockInspection struct {
+	ID            BlockID
+	Good          bool
+	Weight        int
+	Input         string    // the node's own vote on the block, from the layer's input vector
+	Tally         VoteTally // the weighted votes of the good blocks on the block
+	GlobalOpinion string
+	Votes         []BlockVote
+}
+
+// LayerInspection is the tortoise's view of a layer, used to diagnose layers that fail to verify.
+type LayerInspection struct {
+	Layer     LayerID
+	Last      LayerID
+	Verified  LayerID
+	Threshold float64 // the weight of votes needed for a global opinion on the layer's blocks
+	Blocks    []BlockInspection
+	Reason    string // why the layer isn't verified, empty if it is
+}
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+	"math/bits"
 	"strings"
 
 	"github.com/spacemeshos/ed25519"
+	"github.com/spacemeshos/go-spacemesh/common/util"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/signing"
 )
@@ -48,15 +52,39 @@ func TxIdsField(ids []TransactionID) log.Field {
 // EmptyTransactionID is a canonical empty TransactionID.
 var EmptyTransactionID = TransactionID{}
 
+// TxType distinguishes the kinds of transactions.
+type TxType uint32
+
+// Possible values of TxType.
+const (
+	TxTypeTransfer      TxType = iota // moves funds from the account of the key that signed the transaction
+	TxTypeSpend                       // moves funds from a multisig or vault account, signed by enough of its owners
+	TxTypeBatch                       // makes several transfers from the account of the key that signed the transaction
+	TxTypeCreateAccount               // creates a multisig or vault account, funded by the key that signed the transaction
+)
+
+// MaxBatchTransfers is the largest number of transfers a TxTypeBatch transaction may make.
+const MaxBatchTransfers = 100
+
 // Transaction contains all transaction fields, including the signature and cached origin address and transaction ID.
+// Transfers are encoded as their InnerTransaction and Signature only, exactly as they were before the other types were
+// added, and the other types in a typed envelope, so transactions must be encoded with TransactionToBytes rather than
+// InterfaceToBytes.
 type Transaction struct {
 	InnerTransaction
-	Signature [64]byte
-	origin    *Address
-	id        *TransactionID
+	Signature    [64]byte
+	Type         TxType
+	Account      Address         // the multisig or vault account spent from, for TxTypeSpend only
+	Batch        []Transfer      // the transfers made by a TxTypeBatch, which leaves Recipient and Amount empty
+	Control      *AccountControl // the control of the account created by a TxTypeCreateAccount
+	CoSignatures [][64]byte      // signatures of additional owners, for TxTypeSpend only
+	origin       *Address
+	signers      []Address
+	id           *TransactionID
 }
 
-// Origin returns the transaction's origin address: the public key extracted from the transaction signature.
+// Origin returns the transaction's origin address: the account it spends from and whose nonce it uses. For spends
+// it's the spent Account, for the other types it's the public key extracted from the transaction signature.
 func (t *Transaction) Origin() Address {
 	if t.origin == nil {
 		panic("origin not set")
@@ -70,29 +98,106 @@ func (t *Transaction) SetOrigin(origin Address) {
 }
 
 // CalcAndSetOrigin extracts the public key from the transaction's signature and caches it as the transaction's origin
-// address.
+// address. For spend transactions, the origin is the spent account, and the addresses of all the signers are cached
+// instead, see Signers.
 func (t *Transaction) CalcAndSetOrigin() error {
-	txBytes, err := InterfaceToBytes(&t.InnerTransaction)
-	if err != nil {
-		return fmt.Errorf("failed to marshal transaction: %v", err)
+	if err := t.validateType(); err != nil {
+		return err
 	}
-	pubKey, err := ed25519.ExtractPublicKey(txBytes, t.Signature[:])
+	signers, err := t.calcSigners()
 	if err != nil {
-		return fmt.Errorf("failed to extract transaction pubkey: %v", err)
+		return err
+	}
+	if t.Type == TxTypeSpend {
+		t.signers = signers
+		t.SetOrigin(t.Account)
+		return nil
 	}
+	t.SetOrigin(signers[0])
+	return nil
+}
 
-	t.origin = &Address{}
-	t.origin.SetBytes(pubKey)
+// validateType checks that the transaction only sets the fields of its type.
+func (t *Transaction) validateType() error {
+	switch t.Type {
+	case TxTypeTransfer:
+	case TxTypeSpend:
+		if t.Account == (Address{}) {
+			return errors.New("spend must set the account it spends from")
+		}
+	case TxTypeBatch:
+		if len(t.Batch) == 0 {
+			return errors.New("batch has no transfers")
+		}
+		if len(t.Batch) > MaxBatchTransfers {
+			return fmt.Errorf("batch has %d transfers, at most %d are allowed", len(t.Batch), MaxBatchTransfers)
+		}
+		if t.Recipient != (Address{}) || t.Amount != 0 {
+			return errors.New("batch must not set a recipient or amount outside its transfers")
+		}
+	case TxTypeCreateAccount:
+		if t.Control == nil {
+			return errors.New("account creation must set the control of the created account")
+		}
+		if err := t.Control.Validate(); err != nil {
+			return fmt.Errorf("invalid control of created account: %v", err)
+		}
+		if t.Recipient != (Address{}) {
+			return errors.New("account creation must not set a recipient, it funds the created account")
+		}
+	default:
+		return fmt.Errorf("unknown transaction type %d", t.Type)
+	}
+	if t.Type != TxTypeSpend && len(t.CoSignatures) > 0 {
+		return errors.New("only spends can be co-signed")
+	}
+	if t.Type != TxTypeSpend && t.Account != (Address{}) {
+		return errors.New("only spends can set an account")
+	}
+	if t.Type != TxTypeBatch && len(t.Batch) > 0 {
+		return errors.New("only batches can have transfers")
+	}
+	if t.Type != TxTypeCreateAccount && t.Control != nil {
+		return errors.New("only account creations can set a control")
+	}
 	return nil
 }
 
+// Signers returns the addresses of the keys that signed the transaction, starting with the one that made Signature.
+func (t *Transaction) Signers() ([]Address, error) {
+	if t.signers == nil {
+		signers, err := t.calcSigners()
+		if err != nil {
+			return nil, err
+		}
+		t.signers = signers
+	}
+	return t.signers, nil
+}
+
+func (t *Transaction) calcSigners() ([]Address, error) {
+	txBytes, err := t.SignedBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction: %v", err)
+	}
+	signers := make([]Address, 0, 1+len(t.CoSignatures))
+	for _, sig := range append([][64]byte{t.Signature}, t.CoSignatures...) {
+		pubKey, err := ed25519.ExtractPublicKey(txBytes, sig[:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract transaction pubkey: %v", err)
+		}
+		signers = append(signers, BytesToAddress(pubKey))
+	}
+	return signers, nil
+}
+
 // ID returns the transaction's ID. If it's not cached, it's calculated, cached and returned.
 func (t *Transaction) ID() TransactionID {
 	if t.id != nil {
 		return *t.id
 	}
 
-	txBytes, err := InterfaceToBytes(t)
+	txBytes, err := TransactionToBytes(t)
 	if err != nil {
 		panic("failed to marshal transaction: " + err.Error())
 	}
@@ -118,7 +223,8 @@ func (t *Transaction) String() string {
 		t.ID().ShortString(), t.Origin().Short(), t.Recipient.Short(), t.Amount, t.AccountNonce, t.GasLimit, t.Fee)
 }
 
-// InnerTransaction includes all of a transaction's fields, except the signature (origin and id aren't stored).
+// InnerTransaction includes all of a transfer's fields, except the signature (origin and id aren't stored). The other
+// transaction types extend it with the fields of Transaction that follow the signature.
 type InnerTransaction struct {
 	AccountNonce uint64
 	Recipient    Address
@@ -127,6 +233,46 @@ type InnerTransaction struct {
 	Amount       uint64
 }
 
+// Transfer is a single payment made by a transaction.
+type Transfer struct {
+	Recipient Address
+	Amount    uint64
+}
+
+// Transfers returns the payments made by the transaction: the transfers of a batch, the funding of the account created
+// by an account creation, or its Recipient and Amount.
+func (t *Transaction) Transfers() []Transfer {
+	switch t.Type {
+	case TxTypeBatch:
+		return t.Batch
+	case TxTypeCreateAccount:
+		return []Transfer{{Recipient: t.CreatedAccount(), Amount: t.Amount}}
+	}
+	return []Transfer{{Recipient: t.Recipient, Amount: t.Amount}}
+}
+
+// CreatedAccount returns the address of the account created by a TxTypeCreateAccount transaction. It's derived from the
+// transaction's origin and nonce, so each account creation creates a different account.
+func (t *Transaction) CreatedAccount() Address {
+	origin := t.Origin()
+	return BytesToAddress(CalcHash32(append(origin.Bytes(), util.Uint64ToBytes(t.AccountNonce)...)).Bytes())
+}
+
+// TotalAmount returns the sum of the amounts of all the transaction's transfers. ok is false if it doesn't fit in 64
+// bits.
+func (t *Transaction) TotalAmount() (amount uint64, ok bool) {
+	if t.Type != TxTypeBatch {
+		return t.Amount, true
+	}
+	for _, transfer := range t.Batch {
+		var carry uint64
+		if amount, carry = bits.Add64(amount, transfer.Amount, 0); carry != 0 {
+			return 0, false
+		}
+	}
+	return amount, true
+}
+
 // Reward is a virtual reward transaction, which the node keeps track of for the gRPC api.
 type Reward struct {
 	Layer               LayerID
@@ -136,6 +282,43 @@ type Reward struct {
 	Coinbase            Address
 }
 
+// Supply is the emission of layer rewards as of a layer. The amounts don't include transaction fees. Layers count
+// towards the scheduled emission even if none of their blocks are rewarded, but not towards the emitted amount.
+type Supply struct {
+	Layer        LayerID
+	LayerReward  *big.Int // the scheduled reward for the layer
+	EpochReward  *big.Int // the scheduled total reward for the layer's epoch
+	Scheduled    *big.Int // the scheduled total reward for all layers up to and including the layer
+	Emitted      *big.Int // the total layer reward paid out up to and including EmittedLayer, less Burned
+	Burned       *big.Int // the total reward remainder burned up to and including EmittedLayer
+	EmittedLayer LayerID  // the layer, or the latest layer in state if it's earlier
+	Cap          *big.Int // nil if the supply isn't capped
+	Remaining    *big.Int // the reward left to emit as of EmittedLayer, nil if the supply isn't capped
+}
+
+// TxResult is the outcome of applying a transaction to the global state.
+type TxResult int
+
+// Possible values of TxResult.
+const (
+	TxResultUnknown           TxResult = iota // the transaction wasn't applied, or the reason it failed is unknown
+	TxResultApplied                           // the transaction was applied and its fee paid
+	TxResultBadNonce                          // the transaction's nonce didn't match the origin's nonce
+	TxResultInsufficientGas                   // the transaction's gas limit was lower than the gas it needs
+	TxResultInsufficientFunds                 // the origin couldn't pay the amount and fee, or it doesn't exist
+	TxResultUnauthorized                      // the transaction's signers weren't allowed to spend from its origin
+)
+
+// Receipt records the outcome of applying a transaction to the global state, either successfully or not.
+type Receipt struct {
+	TxID    TransactionID
+	Result  TxResult
+	GasUsed uint64
+	Fee     uint64
+	Layer   LayerID
+	Reason  string // why the transaction failed, empty if it was applied
+}
+
 // NewSignedTx is used in TESTS ONLY to generate signed txs
 func NewSignedTx(nonce uint64, rec Address, amount, gas, fee uint64, signer *signing.EdSigner) (*Transaction, error) {
 	inner := InnerTransaction{
@@ -163,3 +346,68 @@ func NewSignedTx(nonce uint64, rec Address, amount, gas, fee uint64, signer *sig
 
 	return sst, nil
 }
+
+// NewSignedSpendTx is used in TESTS ONLY to generate spend txs, signed by all the given signers
+func NewSignedSpendTx(nonce uint64, account, rec Address, amount, gas, fee uint64, signers ...*signing.EdSigner) (*Transaction, error) {
+	sst := &Transaction{
+		InnerTransaction: InnerTransaction{
+			AccountNonce: nonce,
+			Recipient:    rec,
+			Amount:       amount,
+			GasLimit:     gas,
+			Fee:          fee,
+		},
+		Type:    TxTypeSpend,
+		Account: account,
+	}
+	return signTypedTx(sst, signers...)
+}
+
+// NewSignedBatchTx is used in TESTS ONLY to generate signed batch txs
+func NewSignedBatchTx(nonce uint64, transfers []Transfer, gas, fee uint64, signer *signing.EdSigner) (*Transaction, error) {
+	sst := &Transaction{
+		InnerTransaction: InnerTransaction{
+			AccountNonce: nonce,
+			GasLimit:     gas,
+			Fee:          fee,
+		},
+		Type:  TxTypeBatch,
+		Batch: transfers,
+	}
+	return signTypedTx(sst, signer)
+}
+
+// NewSignedCreateAccountTx is used in TESTS ONLY to generate signed account creation txs
+func NewSignedCreateAccountTx(nonce uint64, control AccountControl, amount, gas, fee uint64, signer *signing.EdSigner) (*Transaction, error) {
+	sst := &Transaction{
+		InnerTransaction: InnerTransaction{
+			AccountNonce: nonce,
+			Amount:       amount,
+			GasLimit:     gas,
+			Fee:          fee,
+		},
+		Type:    TxTypeCreateAccount,
+		Control: &control,
+	}
+	return signTypedTx(sst, signer)
+}
+
+func signTypedTx(sst *Transaction, signers ...*signing.EdSigner) (*Transaction, error) {
+	buf, err := sst.SignedBytes()
+	if err != nil {
+		return nil, err
+	}
+	for i, signer := range signers {
+		var sig [64]byte
+		copy(sig[:], signer.Sign(buf))
+		if i == 0 {
+			sst.Signature = sig
+		} else {
+			sst.CoSignatures = append(sst.CoSignatures, sig)
+		}
+	}
+	if err := sst.CalcAndSetOrigin(); err != nil {
+		return nil, err
+	}
+	return sst, nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	xdr "github.com/nullstyle/go-xdr/xdr3"
+	"github.com/spacemeshos/go-spacemesh/signing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_CalcAndSetOrigin_Spend(t *testing.T) {
+	r := require.New(t)
+	signer1, signer2 := signing.NewEdSigner(), signing.NewEdSigner()
+	account := Address{0xaa}
+
+	tx, err := NewSignedSpendTx(3, account, Address{1}, 10, 100, 1, signer1, signer2)
+	r.NoError(err)
+	buf, err := TransactionToBytes(tx)
+	r.NoError(err)
+
+	// the origin of a spend is the account it spends from, not its signer
+	decoded, err := BytesToTransaction(buf)
+	r.NoError(err)
+	r.NoError(decoded.CalcAndSetOrigin())
+	r.Equal(account, decoded.Origin())
+	r.Equal(tx.ID(), decoded.ID())
+	signers, err := decoded.Signers()
+	r.NoError(err)
+	r.Equal([]Address{BytesToAddress(signer1.PublicKey().Bytes()), BytesToAddress(signer2.PublicKey().Bytes())}, signers)
+	r.Equal(GasTransfer+GasCoSignature, decoded.IntrinsicGas())
+
+	// only spends can be co-signed
+	decoded.Type = TxTypeBatch
+	decoded.Account = Address{}
+	decoded.Batch = []Transfer{{Recipient: Address{1}, Amount: 10}}
+	decoded.Recipient, decoded.Amount = Address{}, 0
+	r.EqualError(decoded.CalcAndSetOrigin(), "only spends can be co-signed")
+	decoded.Type = TxTypeSpend + 1
+	r.Error(decoded.CalcAndSetOrigin())
+}
+
+func TestTransaction_Batch(t *testing.T) {
+	r := require.New(t)
+	signer := signing.NewEdSigner()
+	transfers := []Transfer{{Recipient: Address{1}, Amount: 10}, {Recipient: Address{2}, Amount: 20}}
+
+	tx, err := NewSignedBatchTx(0, transfers, 100, 3, signer)
+	r.NoError(err)
+	r.Equal(BytesToAddress(signer.PublicKey().Bytes()), tx.Origin())
+	r.Equal(transfers, tx.Transfers())
+	r.Equal(2*GasTransfer, tx.IntrinsicGas())
+	cost, ok := tx.TotalCost()
+	r.True(ok)
+	r.Equal(30+3*2*GasTransfer, cost)
+
+	_, err = NewSignedBatchTx(0, nil, 100, 3, signer)
+	r.EqualError(err, "batch has no transfers")
+	_, err = NewSignedBatchTx(0, make([]Transfer, MaxBatchTransfers+1), 100, 3, signer)
+	r.EqualError(err, "batch has 101 transfers, at most 100 are allowed")
+
+	tx.Amount = 1
+	r.EqualError(tx.CalcAndSetOrigin(), "batch must not set a recipient or amount outside its transfers")
+	tx.Amount = 0
+	tx.Type = TxTypeTransfer
+	r.EqualError(tx.CalcAndSetOrigin(), "only batches can have transfers")
+	tx.Type = TxTypeBatch
+
+	tx.Batch = append(tx.Batch, Transfer{Recipient: Address{3}, Amount: ^uint64(0)})
+	_, ok = tx.TotalAmount()
+	r.False(ok)
+	_, ok = tx.TotalCost()
+	r.False(ok)
+}
+
+func TestTransaction_TransferEncoding(t *testing.T) {
+	r := require.New(t)
+	signer := signing.NewEdSigner()
+	inner := InnerTransaction{AccountNonce: 7, Recipient: Address{1}, GasLimit: 100, Fee: 2, Amount: 50}
+	innerBytes, err := InterfaceToBytes(&inner)
+	r.NoError(err)
+	tx := &Transaction{InnerTransaction: inner}
+	copy(tx.Signature[:], signer.Sign(innerBytes))
+
+	// transfers are signed and encoded exactly as they were before the typed transactions were added
+	legacy := struct {
+		InnerTransaction
+		Signature [64]byte
+	}{inner, tx.Signature}
+	var w bytes.Buffer
+	_, err = xdr.Marshal(&w, &legacy)
+	r.NoError(err)
+	buf, err := TransactionToBytes(tx)
+	r.NoError(err)
+	r.Equal(w.Bytes(), buf)
+	r.Equal(TransactionID(CalcHash32(w.Bytes())), tx.ID())
+
+	decoded, err := BytesToTransaction(buf)
+	r.NoError(err)
+	r.NoError(decoded.CalcAndSetOrigin())
+	r.Equal(BytesToAddress(signer.PublicKey().Bytes()), decoded.Origin())
+	r.Equal(TxTypeTransfer, decoded.Type)
+
+	tx.AccountNonce = uint64(typedTxMarker) << 32
+	_, err = TransactionToBytes(tx)
+	r.Error(err)
+}
+
+func TestTransaction_CreateAccount(t *testing.T) {
+	r := require.New(t)
+	signer := signing.NewEdSigner()
+	owners := []Address{{1}, {2}, {3}}
+	control := AccountControl{Threshold: 2, Owners: owners, UnlockLayer: 10}
+
+	tx, err := NewSignedCreateAccountTx(4, control, 100, 50, 1, signer)
+	r.NoError(err)
+	buf, err := TransactionToBytes(tx)
+	r.NoError(err)
+	decoded, err := BytesToTransaction(buf)
+	r.NoError(err)
+	r.NoError(decoded.CalcAndSetOrigin())
+	r.Equal(BytesToAddress(signer.PublicKey().Bytes()), decoded.Origin())
+	r.Equal(tx.ID(), decoded.ID())
+	r.Equal(control, *decoded.Control)
+	r.Equal([]Transfer{{Recipient: tx.CreatedAccount(), Amount: 100}}, decoded.Transfers())
+	r.Equal(GasTransfer+GasCreateAccount, decoded.IntrinsicGas())
+
+	// each nonce creates a different account
+	other, err := NewSignedCreateAccountTx(5, control, 100, 50, 1, signer)
+	r.NoError(err)
+	r.NotEqual(tx.CreatedAccount(), other.CreatedAccount())
+
+	decoded.Control = nil
+	r.EqualError(decoded.CalcAndSetOrigin(), "account creation must set the control of the created account")
+	decoded.Control = &AccountControl{Threshold: 4, Owners: owners}
+	r.Error(decoded.CalcAndSetOrigin())
+	decoded.Control = &control
+	decoded.Recipient = Address{1}
+	r.EqualError(decoded.CalcAndSetOrigin(), "account creation must not set a recipient, it funds the created account")
+}
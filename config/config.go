@@ -14,6 +14,7 @@ import (
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/mesh"
 	p2pConfig "github.com/spacemeshos/go-spacemesh/p2p/config"
+	"github.com/spacemeshos/go-spacemesh/state"
 	timeConfig "github.com/spacemeshos/go-spacemesh/timesync/config"
 	postConfig "github.com/spacemeshos/post/config"
 	"github.com/spf13/viper"
@@ -40,6 +41,7 @@ type Config struct {
 	HareEligibility eligConfig.Config     `mapstructure:"hare-eligibility"`
 	TIME            timeConfig.TimeConfig `mapstructure:"time"`
 	REWARD          mesh.Config           `mapstructure:"reward"`
+	TXPOOL          state.PoolConfig      `mapstructure:"txpool"`
 	POST            postConfig.Config     `mapstructure:"post"`
 	LOGGING         LoggerConfig          `mapstructure:"logging"`
 }
@@ -70,6 +72,8 @@ type BaseConfig struct {
 	LayersPerEpoch   int    `mapstructure:"layers-per-epoch"`
 	Hdist            int    `mapstructure:"hdist"`
 
+	FullTortoiseDistance int `mapstructure:"full-tortoise-distance"` // layers behind the last layer after which the full tortoise verifies layers the verifying tortoise can't, 0 to disable
+
 	PoETServer string `mapstructure:"poet-server"`
 
 	MemProfile string `mapstructure:"mem-profile"`
@@ -106,6 +110,16 @@ type BaseConfig struct {
 
 	BlockCacheSize int `mapstructure:"block-cache-size"`
 
+	LayerRetention uint32 `mapstructure:"layer-retention"` // number of layers before the latest layer in state to keep blocks and txs of, 0 to keep all
+
+	StateHistory            uint32 `mapstructure:"state-history"`             // number of latest layers to keep the state tries of, 0 to keep all
+	StateCheckpointInterval uint32 `mapstructure:"state-checkpoint-interval"` // interval in layers between persisted states to recover from
+	StateCacheSize          int    `mapstructure:"state-cache-size"`          // memory in MB that the state tries in the state history may take before they're persisted
+
+	ExplorerIndex bool `mapstructure:"explorer-index"` // index blocks by transaction and smesher, and ATXs by coinbase, for explorer queries
+
+	DatabaseBackend string `mapstructure:"db-backend"` // backend of the node's databases, leveldb (the default) or badger
+
 	AlwaysListen bool `mapstructure:"always-listen"` // force gossip to always be on (for testing)
 
 	Profiler bool `mapstructure:"profiler"`
@@ -149,6 +163,7 @@ func DefaultConfig() Config {
 		HareEligibility: eligConfig.DefaultConfig(),
 		TIME:            timeConfig.DefaultConfig(),
 		REWARD:          mesh.DefaultMeshConfig(),
+		TXPOOL:          state.DefaultPoolConfig(),
 		POST:            activation.DefaultConfig(),
 	}
 }
@@ -186,6 +201,8 @@ func defaultBaseConfig() BaseConfig {
 		AtxsPerBlock:        100,
 		TxsPerBlock:         100,
 		Profiler:            false,
+
+		FullTortoiseDistance: 10,
 	}
 }
 
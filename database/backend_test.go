@@ -0,0 +1,218 @@
+package database
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(i int) []byte {
+	return []byte(fmt.Sprintf("key%05d", i))
+}
+
+// testBackends are the persistent backends, the tests of the behavior they share run against each of them
+var testBackends = []struct {
+	name string
+	open func(t *testing.T, dir string) Database
+}{
+	{LevelDBBackend, func(t *testing.T, dir string) Database {
+		db, err := NewLDBDatabase(dir, 0, 0, log.NewDefault(t.Name()))
+		require.NoError(t, err)
+		return db
+	}},
+	{BadgerBackend, func(t *testing.T, dir string) Database {
+		db, err := NewBadgerDatabase(dir, 0, 0, log.NewDefault(t.Name()))
+		require.NoError(t, err)
+		return db
+	}},
+}
+
+func forEachBackend(t *testing.T, test func(t *testing.T, open func(dir string) Database)) {
+	for _, backend := range testBackends {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			test(t, func(dir string) Database { return backend.open(t, dir) })
+		})
+	}
+}
+
+func TestDatabase_Reopen(t *testing.T) {
+	forEachBackend(t, testReopen)
+}
+
+func testReopen(t *testing.T, open func(dir string) Database) {
+	r := require.New(t)
+	dir, err := ioutil.TempDir("", "db_test_")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+
+	db := open(dir)
+	const n = 3000
+	for i := 0; i < n; i++ {
+		r.NoError(db.Put(testKey(i), []byte(fmt.Sprintf("value%v", i))))
+	}
+	batch := db.NewBatch()
+	for i := 0; i < n; i += 3 {
+		r.NoError(batch.Delete(testKey(i)))
+	}
+	r.NoError(batch.Write())
+
+	check := func(db Database) {
+		for i := 0; i < n; i++ {
+			value, err := db.Get(testKey(i))
+			if i%3 == 0 {
+				r.Equal(ErrNotFound, err)
+				continue
+			}
+			r.NoError(err)
+			r.Equal([]byte(fmt.Sprintf("value%v", i)), value)
+		}
+		i := 1
+		it := db.Find([]byte("key"))
+		for it.Next() {
+			r.Equal(testKey(i), it.Key())
+			if i++; i%3 == 0 {
+				i++
+			}
+		}
+		r.NoError(it.Error())
+		it.Release()
+		r.Equal(n+1, i)
+	}
+	check(db)
+	r.NoError(db.Put([]byte("last"), []byte("value")))
+	db.Close()
+
+	db = open(dir)
+	defer db.Close()
+	check(db)
+	value, err := db.Get([]byte("last"))
+	r.NoError(err)
+	r.Equal([]byte("value"), value)
+}
+
+func TestDatabase_Iterator(t *testing.T) {
+	forEachBackend(t, testIterator)
+}
+
+func testIterator(t *testing.T, open func(dir string) Database) {
+	r := require.New(t)
+	dir, err := ioutil.TempDir("", "db_test_")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+
+	db := open(dir)
+	defer db.Close()
+	for i := 0; i < 10; i++ {
+		r.NoError(db.Put([]byte{1, byte(i)}, []byte{byte(i)}))
+		r.NoError(db.Put([]byte{2, byte(i)}, []byte{byte(i)}))
+	}
+	r.NoError(db.Delete([]byte{1, 0}))
+	r.NoError(db.Delete([]byte{1, 5}))
+	r.NoError(db.Put([]byte{1, 9}, []byte{90}))
+	r.NoError(db.Put([]byte{1, 10}, []byte{10}))
+
+	it := db.Find([]byte{1})
+	// the iterator isn't affected by later writes
+	r.NoError(db.Put([]byte{1, 11}, []byte{11}))
+	var keys []byte
+	for it.Next() {
+		keys = append(keys, it.Key()[1])
+	}
+	r.Nil(it.Key())
+	r.Equal([]byte{1, 2, 3, 4, 6, 7, 8, 9, 10}, keys)
+
+	r.True(it.Last())
+	r.Equal([]byte{1, 10}, it.Key())
+	r.True(it.Prev())
+	r.Equal([]byte{1, 9}, it.Key())
+	r.Equal([]byte{90}, it.Value())
+	r.True(it.Seek([]byte{1, 5}))
+	r.Equal([]byte{1, 6}, it.Key())
+	r.True(it.Prev())
+	r.Equal([]byte{1, 4}, it.Key())
+	r.True(it.Next())
+	r.Equal([]byte{1, 6}, it.Key())
+	r.True(it.First())
+	r.Equal([]byte{1, 1}, it.Key())
+	r.False(it.Prev())
+	r.False(it.Seek([]byte{2}))
+	r.NoError(it.Error())
+	it.Release()
+
+	it = db.Find([]byte{3})
+	r.False(it.Next())
+	r.False(it.Last())
+	it.Release()
+}
+
+func TestNewPersistentDatabase(t *testing.T) {
+	r := require.New(t)
+	dir, err := ioutil.TempDir("", "db_test_")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewPersistentDatabase("rocksdb", dir, 0, 0, log.NewDefault(t.Name()))
+	r.Error(err)
+
+	db, err := NewPersistentDatabase(BadgerBackend, filepath.Join(dir, BadgerBackend), 0, 0, log.NewDefault(t.Name()))
+	r.NoError(err)
+	r.IsType(&BadgerDatabase{}, db)
+	db.Close()
+	// a directory that holds a database of one backend isn't opened with another
+	_, err = NewPersistentDatabase(LevelDBBackend, filepath.Join(dir, BadgerBackend), 0, 0, log.NewDefault(t.Name()))
+	r.Error(err)
+
+	db, err = NewPersistentDatabase("", filepath.Join(dir, LevelDBBackend), 0, 0, log.NewDefault(t.Name()))
+	r.NoError(err)
+	r.IsType(&LDBDatabase{}, db)
+	db.Close()
+	_, err = NewPersistentDatabase(BadgerBackend, filepath.Join(dir, LevelDBBackend), 0, 0, log.NewDefault(t.Name()))
+	r.Error(err)
+}
+
+func TestDatabase_ReadOnly(t *testing.T) {
+	forEachBackend(t, testReadOnly)
+}
+
+func testReadOnly(t *testing.T, open func(dir string) Database) {
+	r := require.New(t)
+	dir, err := ioutil.TempDir("", "db_test_")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewReadOnlyDatabase(filepath.Join(dir, "missing"), log.NewDefault(t.Name()))
+	r.Error(err)
+
+	db := open(dir)
+	for i := 0; i < 500; i++ {
+		r.NoError(db.Put(testKey(i), []byte("value")))
+	}
+	db.Close()
+	files, err := ioutil.ReadDir(dir)
+	r.NoError(err)
+
+	// the backend is detected from the files in the directory
+	ro, err := NewReadOnlyDatabase(dir, log.NewDefault(t.Name()))
+	r.NoError(err)
+	r.IsType(db, ro)
+	_, err = ro.Get(testKey(0))
+	r.NoError(err)
+	_, err = ro.Get(testKey(499))
+	r.NoError(err)
+	r.Error(ro.Put([]byte("key"), []byte("value")))
+	ro.Close()
+
+	after, err := ioutil.ReadDir(dir)
+	r.NoError(err)
+	r.Equal(len(files), len(after))
+	for i := range files {
+		r.Equal(files[i].Name(), after[i].Name())
+		r.Equal(files[i].Size(), after[i].Size())
+	}
+}
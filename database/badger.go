@@ -0,0 +1,396 @@
+package database
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/spacemeshos/go-spacemesh/log"
+)
+
+// badgerManifestName is the name of the manifest file of a Badger database, which tells its directory apart from a
+// LevelDB one, whose manifest files are numbered.
+const badgerManifestName = "MANIFEST"
+
+// badgerKeyPrefix is prepended to every key, since Badger doesn't allow empty keys, which LevelDB does.
+var badgerKeyPrefix = []byte{'k'}
+
+// BadgerDatabase is a wrapper for a Badger database, a log-structured merge tree with a separate value log. Writes are
+// synced to the disk before they return, and a value log that was cut short by a crash is truncated to its last
+// complete write when the database is opened again.
+type BadgerDatabase struct {
+	fn  string     // filename for reporting
+	db  *badger.DB // Badger instance
+	log log.Log
+}
+
+// NewBadgerDatabase opens or creates a Badger database in the directory file, creating its parent directories like
+// LevelDB does. Badger manages its own caches, so cache and handles are ignored.
+func NewBadgerDatabase(file string, cache int, handles int, logger log.Log) (*BadgerDatabase, error) {
+	if err := os.MkdirAll(file, 0700); err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultOptions(file).
+		WithSyncWrites(true).
+		WithTruncate(true).
+		WithLogger(badgerLogger{logger})
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &BadgerDatabase{fn: file, db: db, log: logger}, nil
+}
+
+// NewReadOnlyBadgerDatabase opens an existing Badger database without changing its files. Writes to it fail.
+func NewReadOnlyBadgerDatabase(file string, logger log.Log) (*BadgerDatabase, error) {
+	if _, err := os.Stat(filepath.Join(file, badgerManifestName)); err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultOptions(file).
+		WithReadOnly(true).
+		WithLogger(badgerLogger{logger})
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &BadgerDatabase{fn: file, db: db, log: logger}, nil
+}
+
+func badgerKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(badgerKeyPrefix)+len(key)), badgerKeyPrefix...), key...)
+}
+
+// Path returns the path to the database directory.
+func (db *BadgerDatabase) Path() string {
+	return db.fn
+}
+
+// Put puts the given key / value to the database
+func (db *BadgerDatabase) Put(key []byte, value []byte) error {
+	return db.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(badgerKey(key), value)
+	})
+}
+
+// Has returns whether the db contains the key
+func (db *BadgerDatabase) Has(key []byte) (bool, error) {
+	err := db.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(badgerKey(key))
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Get returns the given key if it's present.
+func (db *BadgerDatabase) Get(key []byte) (value []byte, err error) {
+	err = db.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(badgerKey(key))
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+// Delete deletes the key from the database
+func (db *BadgerDatabase) Delete(key []byte) error {
+	return db.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(badgerKey(key))
+	})
+}
+
+// Find returns iterator to iterate over values with given prefix key. It iterates over a snapshot of the database taken
+// when it's created, so later writes don't affect it.
+func (db *BadgerDatabase) Find(key []byte) Iterator {
+	return &badgerIterator{txn: db.db.NewTransaction(false), prefix: badgerKey(key)}
+}
+
+// Close closes database, flushing writes and denying all new write requests
+func (db *BadgerDatabase) Close() {
+	if err := db.db.Close(); err == nil {
+		db.log.Info("Database closed")
+	} else {
+		db.log.Error("Failed to close database: %v", err)
+	}
+}
+
+// NewBatch returns a batch that writes all of its changes in a single transaction, so either all or none of them are
+// written.
+func (db *BadgerDatabase) NewBatch() Batch {
+	return &badgerBatch{db: db.db}
+}
+
+type badgerBatchOp struct {
+	key, value []byte
+	delete     bool
+}
+
+type badgerBatch struct {
+	db   *badger.DB
+	ops  []badgerBatchOp
+	size int
+}
+
+func (b *badgerBatch) Put(key, value []byte) error {
+	b.ops = append(b.ops, badgerBatchOp{key: badgerKey(key), value: append([]byte(nil), value...)})
+	b.size += len(value)
+	return nil
+}
+
+func (b *badgerBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, badgerBatchOp{key: badgerKey(key), delete: true})
+	b.size++
+	return nil
+}
+
+func (b *badgerBatch) Write() error {
+	return b.db.Update(func(txn *badger.Txn) error {
+		for _, op := range b.ops {
+			var err error
+			if op.delete {
+				err = txn.Delete(op.key)
+			} else {
+				err = txn.Set(op.key, op.value)
+			}
+			if err == badger.ErrTxnTooBig {
+				return fmt.Errorf("batch of %d writes is too big for a single transaction: %v", len(b.ops), err)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *badgerBatch) ValueSize() int {
+	return b.size
+}
+
+func (b *badgerBatch) Reset() {
+	b.ops = nil
+	b.size = 0
+}
+
+// badgerIterator iterates over the keys with a prefix in a read-only transaction. Badger iterators only move in one
+// direction, so a reverse iterator is opened when moving backwards, and each is positioned at the current key when the
+// iterator changes direction.
+type badgerIterator struct {
+	txn      *badger.Txn
+	prefix   []byte
+	forward  *badger.Iterator
+	reverse  *badger.Iterator
+	current  *badger.Iterator // the iterator positioned at the current key, nil before the first move
+	key      []byte
+	value    []byte
+	err      error
+	released bool
+	releaser util.Releaser
+}
+
+func (it *badgerIterator) iterator(reverse bool) *badger.Iterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = it.prefix
+	opts.Reverse = reverse
+	if reverse {
+		if it.reverse == nil {
+			it.reverse = it.txn.NewIterator(opts)
+		}
+		return it.reverse
+	}
+	if it.forward == nil {
+		it.forward = it.txn.NewIterator(opts)
+	}
+	return it.forward
+}
+
+// load positions the iterator at the item the badger iterator is at, if it's valid.
+func (it *badgerIterator) load(i *badger.Iterator) bool {
+	it.current = i
+	if !i.ValidForPrefix(it.prefix) {
+		it.key, it.value = nil, nil
+		return false
+	}
+	item := i.Item()
+	value, err := item.ValueCopy(nil)
+	if err != nil {
+		it.err = err
+		it.key, it.value = nil, nil
+		return false
+	}
+	it.key = item.KeyCopy(nil)[len(badgerKeyPrefix):]
+	it.value = value
+	return true
+}
+
+// prefixEnd returns the first key after all the keys with the prefix. The prefix always starts with badgerKeyPrefix, so
+// there is one.
+func (it *badgerIterator) prefixEnd() []byte {
+	end := append([]byte(nil), it.prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	panic("prefix doesn't start with the key prefix")
+}
+
+// First moves the iterator to the first key.
+func (it *badgerIterator) First() bool {
+	if it.released || it.err != nil {
+		return false
+	}
+	i := it.iterator(false)
+	i.Seek(it.prefix)
+	return it.load(i)
+}
+
+// Last moves the iterator to the last key.
+func (it *badgerIterator) Last() bool {
+	if it.released || it.err != nil {
+		return false
+	}
+	i := it.iterator(true)
+	// a reverse iterator seeks to the last key that is less than or equal to the given key
+	end := it.prefixEnd()
+	i.Seek(end)
+	if i.Valid() && bytes.Equal(i.Item().Key(), end) {
+		i.Next()
+	}
+	return it.load(i)
+}
+
+// Seek moves the iterator to the first key that is greater than or equal to the given key.
+func (it *badgerIterator) Seek(key []byte) bool {
+	if it.released || it.err != nil {
+		return false
+	}
+	i := it.iterator(false)
+	seek := badgerKey(key)
+	if bytes.Compare(seek, it.prefix) < 0 {
+		seek = it.prefix
+	}
+	i.Seek(seek)
+	return it.load(i)
+}
+
+// Next moves the iterator to the next key.
+func (it *badgerIterator) Next() bool {
+	if it.released || it.err != nil {
+		return false
+	}
+	if it.current == nil {
+		return it.First()
+	}
+	if it.key == nil {
+		// the iterator is past the last key or before the first one, where it stays until it's positioned again
+		return false
+	}
+	i := it.iterator(false)
+	if it.current != i {
+		i.Seek(badgerKey(it.key))
+	}
+	i.Next()
+	return it.load(i)
+}
+
+// Prev moves the iterator to the previous key.
+func (it *badgerIterator) Prev() bool {
+	if it.released || it.err != nil {
+		return false
+	}
+	if it.current == nil {
+		return it.Last()
+	}
+	if it.key == nil {
+		return false
+	}
+	i := it.iterator(true)
+	if it.current != i {
+		i.Seek(badgerKey(it.key))
+	}
+	i.Next()
+	return it.load(i)
+}
+
+// Valid returns whether the iterator is positioned at a key.
+func (it *badgerIterator) Valid() bool {
+	return it.key != nil
+}
+
+// Key returns the current key, or nil if the iterator isn't positioned at a key.
+func (it *badgerIterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the current value, or nil if the iterator isn't positioned at a key.
+func (it *badgerIterator) Value() []byte {
+	return it.value
+}
+
+// Error returns the error that stopped the iteration, if any.
+func (it *badgerIterator) Error() error {
+	return it.err
+}
+
+// SetReleaser sets the releaser that is called when the iterator is released.
+func (it *badgerIterator) SetReleaser(releaser util.Releaser) {
+	if !it.released {
+		it.releaser = releaser
+	}
+}
+
+// Release closes the iterator and discards its transaction.
+func (it *badgerIterator) Release() {
+	if it.released {
+		return
+	}
+	it.released = true
+	it.key, it.value, it.current = nil, nil, nil
+	if it.forward != nil {
+		it.forward.Close()
+	}
+	if it.reverse != nil {
+		it.reverse.Close()
+	}
+	it.txn.Discard()
+	if it.releaser != nil {
+		it.releaser.Release()
+		it.releaser = nil
+	}
+}
+
+// badgerLogger writes the logs of Badger to the database's logger.
+type badgerLogger struct {
+	log.Log
+}
+
+func (l badgerLogger) Errorf(format string, args ...interface{}) {
+	l.Error(strings.TrimSuffix(format, "\n"), args...)
+}
+
+func (l badgerLogger) Warningf(format string, args ...interface{}) {
+	l.Warning(strings.TrimSuffix(format, "\n"), args...)
+}
+
+func (l badgerLogger) Infof(format string, args ...interface{}) {
+	l.Info(strings.TrimSuffix(format, "\n"), args...)
+}
+
+func (l badgerLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(strings.TrimSuffix(format, "\n"), args...)
+}
@@ -85,6 +85,23 @@ func NewLDBDatabase(file string, cache int, handles int, logger log.Log) (*LDBDa
 	}, nil
 }
 
+// NewReadOnlyLDBDatabase opens an existing LevelDB database without changing its files. Writes to it fail.
+func NewReadOnlyLDBDatabase(file string, logger log.Log) (*LDBDatabase, error) {
+	db, err := leveldb.OpenFile(file, &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+		Filter:         filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LDBDatabase{
+		fn:  file,
+		db:  db,
+		log: logger,
+	}, nil
+}
+
 // Path returns the path to the database directory.
 func (db *LDBDatabase) Path() string {
 	return db.fn
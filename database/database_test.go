@@ -210,3 +210,31 @@ func testParallelPutGet(db database.Database, t *testing.T) {
 	}
 	pending.Wait()
 }
+
+func newTestBadger() (*database.BadgerDatabase, func()) {
+	dirname, err := ioutil.TempDir(os.TempDir(), "badger_test_")
+	if err != nil {
+		panic("failed to create test file: " + err.Error())
+	}
+	db, err := database.NewBadgerDatabase(dirname, 0, 0, log.NewDefault("db.db"))
+	if err != nil {
+		panic("failed to create test database: " + err.Error())
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dirname)
+	}
+}
+
+func TestBadger_PutGet(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+	testPutGet(db, t)
+}
+
+func TestBadger_ParallelPutGet(t *testing.T) {
+	db, remove := newTestBadger()
+	defer remove()
+	testParallelPutGet(db, t)
+}
@@ -17,8 +17,10 @@
 package database
 
 import (
+	"fmt"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"os"
 	"path/filepath"
 	"time"
 )
@@ -65,6 +67,49 @@ type Iterator interface {
 	iterator.IteratorSeeker
 	Key() []byte
 	Value() []byte
+	// Release releases the resources held by the iterator, it must be called when the iterator isn't used anymore
+	Release()
+	// Error returns the error that stopped the iteration, if any
+	Error() error
+}
+
+// The persistent database backends
+const (
+	LevelDBBackend = "leveldb"
+	BadgerBackend  = "badger"
+)
+
+// Backends are the names of the persistent database backends
+var Backends = []string{LevelDBBackend, BadgerBackend}
+
+// NewPersistentDatabase opens or creates a database in the directory file with the given backend, an empty name selects
+// leveldb. It fails if the directory holds a database of another backend.
+func NewPersistentDatabase(backend, file string, cache int, handles int, logger log.Log) (Database, error) {
+	_, err := os.Stat(filepath.Join(file, badgerManifestName))
+	isBadger := err == nil
+	switch backend {
+	case "", LevelDBBackend:
+		if isBadger {
+			return nil, fmt.Errorf("%v holds a %v database", file, BadgerBackend)
+		}
+		return NewLDBDatabase(file, cache, handles, logger)
+	case BadgerBackend:
+		if _, err := os.Stat(filepath.Join(file, "CURRENT")); err == nil && !isBadger {
+			return nil, fmt.Errorf("%v holds a %v database", file, LevelDBBackend)
+		}
+		return NewBadgerDatabase(file, cache, handles, logger)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q, expected %v or %v", backend, LevelDBBackend, BadgerBackend)
+	}
+}
+
+// NewReadOnlyDatabase opens an existing database in the directory file without changing its files, whichever backend
+// created it. Writes to it fail.
+func NewReadOnlyDatabase(file string, logger log.Log) (Database, error) {
+	if _, err := os.Stat(filepath.Join(file, badgerManifestName)); err == nil {
+		return NewReadOnlyBadgerDatabase(file, logger)
+	}
+	return NewReadOnlyLDBDatabase(file, logger)
 }
 
 // ContextDBCreator is a global structure that toggles creation of real dbs and memory dbs for tests
@@ -72,11 +117,12 @@ type ContextDBCreator struct {
 	Create  func(file string, cache int, handles int, logger log.Log) (Database, error)
 	Path    string
 	Context string
+	Backend string
 }
 
-// CreateRealDB is a wrapper function that creates a leveldb database
+// CreateRealDB is a wrapper function that creates a database with the context's backend
 func (c ContextDBCreator) CreateRealDB(file string, cache int, handles int, logger log.Log) (Database, error) {
-	return NewLDBDatabase(filepath.Join(c.Path+c.Context, file), cache, handles, logger)
+	return NewPersistentDatabase(c.Backend, filepath.Join(c.Path+c.Context, file), cache, handles, logger)
 }
 
 // CreateMemDB is a wrapper function that creates a memory database to be used only in tests
@@ -143,13 +143,21 @@ func (ValidAtx) GetChannel() ChannelID {
 	return EventAtxValid
 }
 
-// NewTx signals that a new transaction has been received and not yet validated
+// NewTx signals that a new transaction has been received and not yet validated. Destination is empty for batches,
+// which make several transfers, and Amount is the total amount of all the transfers.
 type NewTx struct {
 	ID          string
 	Origin      string
 	Destination string
 	Amount      uint64
 	Fee         uint64
+	Transfers   []Transfer
+}
+
+// Transfer is a single payment made by a transaction
+type Transfer struct {
+	Destination string
+	Amount      uint64
 }
 
 // GetChannel gets the message type which means on which this message should be sent
@@ -3,6 +3,7 @@ package events
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
@@ -23,24 +24,41 @@ func init() {
 
 // ReportNewTx dispatches incoming events to the reporter singleton
 func ReportNewTx(tx *types.Transaction) {
+	amount, _ := tx.TotalAmount()
+	transfers := make([]Transfer, 0, len(tx.Transfers()))
+	for _, transfer := range tx.Transfers() {
+		transfers = append(transfers, Transfer{Destination: transfer.Recipient.String(), Amount: transfer.Amount})
+	}
+	destination := ""
+	if len(transfers) == 1 {
+		destination = transfers[0].Destination
+	}
 	Publish(NewTx{
 		ID:          tx.ID().String(),
 		Origin:      tx.Origin().String(),
-		Destination: tx.Recipient.String(),
-		Amount:      tx.Amount,
+		Destination: destination,
+		Amount:      amount,
 		Fee:         tx.Fee,
+		Transfers:   transfers,
 	})
 	ReportTxWithValidity(tx, true)
 }
 
 // ReportTxWithValidity reports a tx along with whether it was just invalidated
 func ReportTxWithValidity(tx *types.Transaction, valid bool) {
-	mu.RLock()
-	defer mu.RUnlock()
 	txWithValidity := TransactionWithValidity{
 		Transaction: tx,
 		Valid:       valid,
 	}
+	if !valid {
+		txWithValidity.Reason = TxDropReasonConflicting
+	}
+	reportTx(txWithValidity)
+}
+
+func reportTx(txWithValidity TransactionWithValidity) {
+	mu.RLock()
+	defer mu.RUnlock()
 	if reporter != nil {
 		if reporter.blocking {
 			reporter.channelTransaction <- txWithValidity
@@ -56,6 +74,25 @@ func ReportTxWithValidity(tx *types.Transaction, valid bool) {
 	}
 }
 
+// ReportTxEvicted reports a tx that was dropped from the mempool to make room for other transactions
+func ReportTxEvicted(tx *types.Transaction) {
+	reportTx(TransactionWithValidity{
+		Transaction: tx,
+		Valid:       false,
+		Reason:      TxDropReasonEvicted,
+	})
+}
+
+// ReportTxReplaced reports a tx that was dropped from the mempool since a tx with the same nonce and a higher fee
+// replaced it
+func ReportTxReplaced(tx *types.Transaction) {
+	reportTx(TransactionWithValidity{
+		Transaction: tx,
+		Valid:       false,
+		Reason:      TxDropReasonReplaced,
+	})
+}
+
 // ReportValidTx reports a valid transaction
 func ReportValidTx(tx *types.Transaction, valid bool) {
 	Publish(ValidTx{ID: tx.ID().String(), Valid: valid})
@@ -173,6 +210,27 @@ func ReportNewLayer(layer NewLayer) {
 	}
 }
 
+// ReportReorg reports that the tortoise changed the set of valid blocks of layers whose valid blocks were already
+// reported
+func ReportReorg(reorg Reorg) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if reporter != nil {
+		if reporter.blocking {
+			reporter.channelReorg <- reorg
+			log.With().Debug("reported reorg", reorg)
+		} else {
+			select {
+			case reporter.channelReorg <- reorg:
+				log.With().Debug("reported reorg", reorg)
+			default:
+				log.With().Debug("not reporting reorg as no one is listening", reorg)
+			}
+		}
+	}
+}
+
 // ReportError reports an error
 func ReportError(err NodeError) {
 	mu.RLock()
@@ -297,6 +355,17 @@ func GetLayerChannel() chan NewLayer {
 	return nil
 }
 
+// GetReorgChannel returns a channel of reorgs
+func GetReorgChannel() chan Reorg {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if reporter != nil {
+		return reporter.channelReorg
+	}
+	return nil
+}
+
 // GetErrorChannel returns a channel for node errors
 func GetErrorChannel() chan NodeError {
 	mu.RLock()
@@ -417,6 +486,28 @@ func (nl NewLayer) Field() log.Field {
 			nl.Status, nl.Layer.Index(), len(nl.Layer.Blocks())))
 }
 
+// LayerReorg is a layer whose set of valid blocks changed after it was reported
+type LayerReorg struct {
+	Layer    types.LayerID
+	OldValid []types.BlockID // the valid blocks reported before
+	NewValid []types.BlockID // the valid blocks according to the tortoise now
+}
+
+// Reorg lists the layers whose valid blocks were changed by a single tortoise run, in order of layer. Transactions
+// that are only in blocks of OldValid may no longer be applied, and receipts seen for them should be discarded.
+type Reorg struct {
+	Layers []LayerReorg
+}
+
+// Field returns a log field. Implements the LoggableField interface.
+func (r Reorg) Field() log.Field {
+	var layers []string
+	for _, l := range r.Layers {
+		layers = append(layers, fmt.Sprintf("%d: %d -> %d valid blocks", l.Layer, len(l.OldValid), len(l.NewValid)))
+	}
+	return log.String("reorg", strings.Join(layers, ", "))
+}
+
 // NodeError represents an internal error to be reported
 type NodeError struct {
 	Msg   string
@@ -447,10 +538,19 @@ type Reward struct {
 	Smesher types.NodeID
 }
 
+// The reason a transaction was dropped from the mempool
+const (
+	TxDropReasonNone        = iota
+	TxDropReasonConflicting // another tx with the same nonce was included in a block
+	TxDropReasonEvicted     // evicted to keep the mempool within its configured limits
+	TxDropReasonReplaced    // replaced by a tx with the same nonce that pays a higher fee
+)
+
 // TransactionWithValidity wraps a tx with its validity info
 type TransactionWithValidity struct {
 	Transaction *types.Transaction
 	Valid       bool
+	Reason      int // the reason the tx was dropped, if it isn't valid
 }
 
 // EventReporter is the struct that receives incoming events and dispatches them
@@ -458,6 +558,7 @@ type EventReporter struct {
 	channelTransaction chan TransactionWithValidity
 	channelActivation  chan *types.ActivationTx
 	channelLayer       chan NewLayer
+	channelReorg       chan Reorg
 	channelError       chan NodeError
 	channelStatus      chan struct{}
 	channelAccount     chan types.Address
@@ -472,6 +573,7 @@ func newEventReporter(bufsize int, blocking bool) *EventReporter {
 		channelTransaction: make(chan TransactionWithValidity, bufsize),
 		channelActivation:  make(chan *types.ActivationTx, bufsize),
 		channelLayer:       make(chan NewLayer, bufsize),
+		channelReorg:       make(chan Reorg, bufsize),
 		channelStatus:      make(chan struct{}, bufsize),
 		channelAccount:     make(chan types.Address, bufsize),
 		channelReward:      make(chan Reward, bufsize),
@@ -490,6 +592,7 @@ func CloseEventReporter() {
 		close(reporter.channelTransaction)
 		close(reporter.channelActivation)
 		close(reporter.channelLayer)
+		close(reporter.channelReorg)
 		close(reporter.channelError)
 		close(reporter.channelStatus)
 		close(reporter.channelAccount)
@@ -0,0 +1,41 @@
+// Package lightclient lets clients that don't hold the global state check account data served by a node, given a
+// state root they trust, e.g. one agreed on by several nodes.
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/crypto"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/rlp"
+	"github.com/spacemeshos/go-spacemesh/trie"
+)
+
+// VerifyAccount checks a Merkle proof of the account associated with addr against the state root, and returns the
+// account's proven state. Accounts that the proof shows don't exist have an empty state. It returns an error if the
+// proof is invalid or doesn't lead to the root.
+func VerifyAccount(root types.Hash32, addr types.Address, nodes [][]byte) (*types.AccountState, error) {
+	proofDb := database.NewMemDatabase()
+	for _, node := range nodes {
+		if err := proofDb.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	// the state trie is keyed by the hash of the address
+	value, _, err := trie.VerifyProof(root, crypto.Keccak256(addr[:]), proofDb)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof for account %v: %v", addr.Short(), err)
+	}
+	var account types.AccountState
+	if value == nil {
+		return &account, nil
+	}
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return nil, fmt.Errorf("invalid account %v in proof: %v", addr.Short(), err)
+	}
+	if len(account.Control) == 0 {
+		account.Control = nil
+	}
+	return &account, nil
+}
@@ -0,0 +1,48 @@
+package lightclient
+
+import (
+	"testing"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAccount(t *testing.T) {
+	r := require.New(t)
+	st, err := state.New(types.Hash32{}, state.NewDatabase(database.NewMemDatabase()))
+	r.NoError(err)
+	for i := byte(1); i <= 20; i++ {
+		st.SetBalance(types.Address{i}, uint64(i)*100)
+		st.SetNonce(types.Address{i}, uint64(i))
+	}
+	root, err := st.Commit()
+	r.NoError(err)
+
+	proof, err := st.GetProof(types.Address{7})
+	r.NoError(err)
+	account, err := VerifyAccount(root, types.Address{7}, proof)
+	r.NoError(err)
+	r.Equal(types.AccountState{Nonce: 7, Balance: 700}, *account)
+
+	// the proof doesn't hold for another root
+	_, err = VerifyAccount(types.Hash32{1}, types.Address{7}, proof)
+	r.Error(err)
+
+	// a tampered node breaks the proof
+	tampered := make([][]byte, len(proof))
+	copy(tampered, proof)
+	last := append([]byte{}, proof[len(proof)-1]...)
+	last[len(last)-1] ^= 1
+	tampered[len(tampered)-1] = last
+	_, err = VerifyAccount(root, types.Address{7}, tampered)
+	r.Error(err)
+
+	// accounts that don't exist are proven empty
+	proof, err = st.GetProof(types.Address{0xff})
+	r.NoError(err)
+	account, err = VerifyAccount(root, types.Address{0xff}, proof)
+	r.NoError(err)
+	r.Equal(types.AccountState{}, *account)
+}
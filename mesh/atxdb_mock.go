@@ -49,6 +49,17 @@ func (t *AtxDbMock) ProcessAtxs(atxs []*types.ActivationTx) error {
 	return nil
 }
 
+// GetCoinbaseAtxIDs returns the IDs of the stored ATXs with the given coinbase, in no particular order
+func (t *AtxDbMock) GetCoinbaseAtxIDs(coinbase types.Address) ([]types.ATXID, error) {
+	var ids []types.ATXID
+	for id, atx := range t.db {
+		if atx.Coinbase == coinbase {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
 // SyntacticallyValidateAtx always returns no error
 func (AtxDbMock) SyntacticallyValidateAtx(*types.ActivationTx) error {
 	return nil
@@ -35,3 +35,7 @@ func (bc blockCache) Get(id types.BlockID) *types.Block {
 	blk := item.(types.Block)
 	return &blk
 }
+
+func (bc blockCache) remove(id types.BlockID) {
+	bc.Cache.Remove(id)
+}
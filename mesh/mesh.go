@@ -29,10 +29,21 @@ var constFalse = []byte{0}
 var constLATEST = []byte("latest")
 var constLAYERHASH = []byte("layer hash")
 var constPROCESSED = []byte("processed")
+var constPRUNED = []byte("pruned")
 
-// TORTOISE key for tortoise persistence in database
+// TORTOISE key of the whole tortoise as persisted by earlier versions, it's only read to migrate it
 var TORTOISE = []byte("tortoise")
 
+// TortoiseHeader key for the persisted state of the tortoise, without the state of its layers
+var TortoiseHeader = []byte("tortoise_header")
+
+var tortoiseLayerPrefix = []byte("tortoise_layer_")
+
+// TortoiseLayerKey returns the key for the persisted state of the tortoise's blocks of a layer
+func TortoiseLayerKey(l types.LayerID) []byte {
+	return append(append([]byte{}, tortoiseLayerPrefix...), l.Bytes()...)
+}
+
 // VERIFIED refers to layers we pushed into the state
 var VERIFIED = []byte("verified")
 
@@ -53,16 +64,21 @@ type Validator interface {
 
 type txProcessor interface {
 	ApplyTransactions(layer types.LayerID, txs []*types.Transaction) (int, error)
-	ApplyRewards(layer types.LayerID, miners []types.Address, reward *big.Int)
+	ApplyRewards(layer types.LayerID, miners []types.Address, reward *big.Int) error
 	AddressExists(addr types.Address) bool
 	ValidateNonceAndBalance(transaction *types.Transaction) error
 	GetLayerApplied(txID types.TransactionID) *types.LayerID
+	GetReceipt(txID types.TransactionID) (*types.Receipt, error)
 	GetLayerStateRoot(layer types.LayerID) (types.Hash32, error)
 	GetStateRoot() types.Hash32
 	LoadState(layer types.LayerID) error
+	RevertLayers(layer, latest types.LayerID, txIDs []types.TransactionID) error
 	ValidateAndAddTxToPool(tx *types.Transaction) error
 	GetBalance(addr types.Address) uint64
 	GetNonce(addr types.Address) uint64
+	GetAccountControl(addr types.Address) *types.AccountControl
+	GetAccountAtLayer(addr types.Address, layer types.LayerID) (*types.AccountState, error)
+	GetAccountProof(addr types.Address, layer types.LayerID) (*types.AccountProof, error)
 	GetAllAccounts() (*types.MultipleAccountsState, error)
 }
 
@@ -78,6 +94,7 @@ type AtxDB interface {
 	GetAtxHeader(id types.ATXID) (*types.ActivationTxHeader, error)
 	GetFullAtx(id types.ATXID) (*types.ActivationTx, error)
 	SyntacticallyValidateAtx(atx *types.ActivationTx) error
+	GetCoinbaseAtxIDs(coinbase types.Address) ([]types.ATXID, error)
 }
 
 // Mesh is the logic layer above our mesh.DB database
@@ -90,6 +107,7 @@ type Mesh struct {
 	trtl               tortoise
 	txPool             txMemPool
 	config             Config
+	schedule           emissionSchedule
 	latestLayer        types.LayerID
 	latestLayerInState types.LayerID
 	layerHash          []byte
@@ -147,14 +165,13 @@ func NewRecoveredMesh(db *DB, atxDb AtxDB, rewardConfig Config, mesh tortoise, t
 		logger.With().Error("could not recover latest layer hash", log.Err(err))
 	}
 
-	verified, err := db.general.Get(VERIFIED)
+	verified, err := db.getLatestLayerInState()
 	if err != nil {
 		logger.Panic("could not recover latest verified layer: %v", err)
 	}
 	msh.latestLayerInState = types.LayerID(util.BytesToUint64(verified))
 
-	err = pr.LoadState(msh.LatestLayerInState())
-	if err != nil {
+	if err := msh.loadRecoveredState(); err != nil {
 		logger.Panic("cannot load state for layer %v, message: %v", msh.LatestLayerInState(), err)
 	}
 	// in case we load a state that was not fully played
@@ -173,6 +190,72 @@ func NewRecoveredMesh(db *DB, atxDb AtxDB, rewardConfig Config, mesh tortoise, t
 	return msh
 }
 
+// loadRecoveredState loads the state of the latest layer in state. When old states are garbage collected, the state
+// tries of the latest layers are only persisted at checkpoints and when the node stops, so after a crash the latest
+// persisted state may be of an earlier layer. The latest layer in state is then rewound to it, so the layers after it
+// are applied again, and their rewards, state roots, receipts and applied transactions are deleted.
+func (msh *Mesh) loadRecoveredState() error {
+	latest := msh.LatestLayerInState()
+	err := msh.LoadState(latest)
+	if err == nil {
+		return nil
+	}
+	for l := latest; l > 0; l-- {
+		if msh.LoadState(l-1) == nil {
+			msh.With().Warning("state of latest layer in state wasn't persisted, rewinding to latest persisted state",
+				log.FieldNamed("latest_layer_in_state", latest),
+				log.FieldNamed("persisted_layer", l-1),
+				log.Err(err))
+			return msh.rewindState(l-1, latest)
+		}
+	}
+	return err
+}
+
+// rewindState marks the layer as the latest layer in state, once the state was loaded from it, and deletes what was
+// recorded when the layers after it, up to and including latest, were applied: their rewards, state roots, receipts and
+// applied transactions. The layers after it are then applied again.
+func (msh *Mesh) rewindState(layer, latest types.LayerID) error {
+	var txIDs []types.TransactionID
+	for l := layer + 1; l <= latest; l++ {
+		lyr, err := msh.GetLayer(l)
+		if err != nil && err != database.ErrNotFound {
+			return fmt.Errorf("failed to read rewound layer %v: %v", l, err)
+		}
+		if lyr != nil {
+			for _, b := range lyr.Blocks() {
+				txIDs = append(txIDs, b.TxIDs...)
+			}
+		}
+	}
+	if err := msh.RevertLayers(layer, latest, txIDs); err != nil {
+		return fmt.Errorf("failed to revert rewound layers: %v", err)
+	}
+	if err := msh.rewindLayersInState(layer, latest); err != nil {
+		return fmt.Errorf("failed to rewind latest layer in state: %v", err)
+	}
+	msh.pMutex.Lock()
+	msh.latestLayerInState = layer
+	msh.pMutex.Unlock()
+	return nil
+}
+
+// revertState reverts the state to the layer before the given one, so the layer and the layers after it, up to and
+// including the latest layer in state, are applied again with their current valid blocks. It does nothing if the layer
+// wasn't applied yet.
+func (msh *Mesh) revertState(layerID types.LayerID) error {
+	msh.txMutex.Lock()
+	defer msh.txMutex.Unlock()
+	latest := msh.LatestLayerInState()
+	if layerID > latest {
+		return nil
+	}
+	if err := msh.LoadState(layerID - 1); err != nil {
+		return fmt.Errorf("failed to load state of layer %v: %v", layerID-1, err)
+	}
+	return msh.rewindState(layerID-1, latest)
+}
+
 // CacheWarmUp warms up cache with latest blocks
 func (msh *Mesh) CacheWarmUp(layerSize int) {
 	start := types.LayerID(0)
@@ -304,7 +387,15 @@ func (msh *Mesh) pushLayersToState(oldPbase types.LayerID, newPbase types.LayerI
 		}
 		oldPbase = 2
 	}
-	for layerID := oldPbase; layerID < newPbase; layerID++ {
+	// a reorg is reported once the layers whose valid blocks changed, and the layers after them, were applied again
+	var reorg events.Reorg
+	defer func() {
+		if len(reorg.Layers) > 0 {
+			events.ReportReorg(reorg)
+		}
+	}()
+	var reapply types.LayerID // the latest layer in state before the state was reverted, if it was
+	for layerID := oldPbase; layerID < newPbase || layerID <= reapply; layerID++ {
 		l, err := msh.GetLayer(layerID)
 		// TODO: propagate/handle error
 		if err != nil || l == nil {
@@ -316,14 +407,67 @@ func (msh *Mesh) pushLayersToState(oldPbase types.LayerID, newPbase types.LayerI
 			return
 		}
 		validBlocks, invalidBlocks := msh.BlocksByValidity(l.Blocks())
+		oldValid, reported := msh.getValidBlocks(layerID)
+		newValid := types.SortBlockIDs(types.BlockIDs(validBlocks))
+		if reported && !equalBlockIDs(oldValid, newValid) {
+			msh.With().Warning("tortoise changed the valid blocks of layer", layerID,
+				log.Int("old_valid_blocks", len(oldValid)), log.Int("new_valid_blocks", len(newValid)))
+			reorg.Layers = append(reorg.Layers, events.LayerReorg{Layer: layerID, OldValid: oldValid, NewValid: newValid})
+			if latest := msh.LatestLayerInState(); latest >= layerID {
+				if err := msh.revertState(layerID); err != nil {
+					msh.With().Error("failed to revert state to apply the layer's new valid blocks", layerID, log.Err(err))
+				} else if latest > reapply {
+					reapply = latest
+				}
+			}
+		}
 		msh.updateStateWithLayer(layerID, types.NewExistingLayer(layerID, validBlocks))
 		msh.logStateRoot(l.Index())
 		msh.persistLayerHashes(l)
 		msh.reInsertTxsToPool(validBlocks, invalidBlocks, l.Index())
+		msh.persistValidBlocks(layerID, newValid)
 	}
 	msh.persistLastLayerHash()
 }
 
+// persistValidBlocks records the valid blocks of the layer that were reported to the API, so that a later change by the
+// tortoise can be reported as a reorg.
+func (msh *Mesh) persistValidBlocks(layerID types.LayerID, ids []types.BlockID) {
+	bytes, err := types.InterfaceToBytes(types.SortBlockIDs(ids))
+	if err == nil {
+		err = msh.general.Put(msh.getValidBlocksKey(layerID), bytes)
+	}
+	if err != nil {
+		msh.With().Error("failed to persist valid blocks", layerID, log.Err(err))
+	}
+}
+
+// getValidBlocks returns the valid blocks of the layer that were reported to the API, sorted by ID. ok is false if none
+// were reported yet.
+func (msh *Mesh) getValidBlocks(layerID types.LayerID) (ids []types.BlockID, ok bool) {
+	bytes, err := msh.general.Get(msh.getValidBlocksKey(layerID))
+	if err != nil {
+		return nil, false
+	}
+	if err := types.BytesToInterface(bytes, &ids); err != nil {
+		msh.With().Error("failed to read valid blocks", layerID, log.Err(err))
+		return nil, false
+	}
+	return ids, true
+}
+
+func equalBlockIDs(a, b []types.BlockID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (msh *Mesh) persistLayerHashes(l *types.Layer) {
 	hash := msh.calcLayerHash(l)
 	msh.persistLayerHash(l.Index(), hash)
@@ -357,14 +501,60 @@ func (msh *Mesh) reInsertTxsToPool(validBlocks, invalidBlocks []*types.Block, l
 	}
 }
 
-func (msh *Mesh) applyState(l *types.Layer) {
-	msh.accumulateRewards(l, msh.config)
-	msh.pushTransactions(l)
-	msh.setLatestLayerInState(l.Index())
+// applyState applies the rewards and transactions of the layer to the state, commits it and records the rewards. If it
+// fails, the state is reverted to the latest layer in state and the layer isn't marked as applied.
+func (msh *Mesh) applyState(l *types.Layer) error {
+	if err := msh.applyLayerToState(l); err != nil {
+		latest := msh.LatestLayerInState()
+		if loadErr := msh.LoadState(latest); loadErr != nil {
+			msh.With().Error("failed to revert state", latest, log.Err(loadErr))
+		}
+		// the layer's state root and receipts are written when its state is committed, which may be before it failed
+		var txIDs []types.TransactionID
+		for _, b := range l.Blocks() {
+			txIDs = append(txIDs, b.TxIDs...)
+		}
+		if revertErr := msh.RevertLayers(latest, l.Index(), txIDs); revertErr != nil {
+			msh.With().Error("failed to revert layer that failed to apply", l.Index(), log.Err(revertErr))
+		}
+		return err
+	}
+	msh.persistValidBlocks(l.Index(), types.BlockIDs(l.Blocks()))
 	events.ReportNewLayer(events.NewLayer{
 		Layer:  l,
 		Status: events.LayerStatusTypeApproved,
 	})
+	msh.pruneOldLayers(l.Index())
+	return nil
+}
+
+// pruneOldLayers prunes the blocks and transactions of the layers that are more than LayerRetention layers before the
+// latest layer in state, if LayerRetention is set.
+func (msh *Mesh) pruneOldLayers(latestInState types.LayerID) {
+	retention := types.LayerID(msh.LayerRetention)
+	if retention == 0 || latestInState <= retention {
+		return
+	}
+	if err := msh.pruneLayers(latestInState-retention, msh.GetLayerApplied); err != nil {
+		msh.With().Error("failed to prune old layers", log.Err(err))
+	}
+}
+
+// applyLayerToState applies the rewards and transactions of the layer to the state and commits it, then marks the
+// layer as the latest layer in state along with its rewards.
+func (msh *Mesh) applyLayerToState(l *types.Layer) error {
+	rewards, err := msh.accumulateRewards(l, msh.config)
+	if err != nil {
+		return err
+	}
+	if err := msh.pushTransactions(l); err != nil {
+		return err
+	}
+	if err := msh.setLatestLayerInState(l.Index(), rewards); err != nil {
+		return fmt.Errorf("failed to write rewards of applied layer: %v", err)
+	}
+	msh.reportRewards(l.Index(), rewards)
+	return nil
 }
 
 // HandleValidatedLayer handles layer valid blocks as decided by hare
@@ -432,33 +622,39 @@ func (msh *Mesh) updateStateWithLayer(validatedLayer types.LayerID, layer *types
 	if msh.maxValidatedLayer < validatedLayer {
 		msh.maxValidatedLayer = validatedLayer
 	}
-	if validatedLayer > latest+1 {
+	// layers are applied in order, and a layer that fails to apply is kept with the early ones, so it's applied again
+	// along with the next layer result
+	msh.nextValidLayers[validatedLayer] = layer
+	if _, has := msh.nextValidLayers[latest+1]; !has {
 		msh.With().Warning("early layer result received",
 			log.FieldNamed("validatedLayer", validatedLayer),
 			log.FieldNamed("maxValidatedLayer", msh.maxValidatedLayer),
 			log.FieldNamed("latestLayer", latest))
-		msh.nextValidLayers[validatedLayer] = layer
 		return
 	}
-	msh.applyState(layer)
-	for i := validatedLayer + 1; i <= msh.maxValidatedLayer; i++ {
+	for i := latest + 1; i <= msh.maxValidatedLayer; i++ {
 		nxtLayer, has := msh.nextValidLayers[i]
 		if !has {
 			break
 		}
-		msh.applyState(nxtLayer)
+		if err := msh.applyState(nxtLayer); err != nil {
+			msh.With().Error("failed to apply layer to state, will retry with the next layer result", i, log.Err(err))
+			return
+		}
 		delete(msh.nextValidLayers, i)
 	}
 }
 
-func (msh *Mesh) setLatestLayerInState(lyr types.LayerID) {
+// setLatestLayerInState marks the layer as the latest layer applied to the state, writing its rewards along with it.
+func (msh *Mesh) setLatestLayerInState(lyr types.LayerID, rewards *layerRewards) error {
 	// update validated layer only after applying transactions since loading of state depends on processedLayer param.
 	msh.pMutex.Lock()
-	if err := msh.general.Put(VERIFIED, lyr.Bytes()); err != nil {
-		msh.Panic("could not persist validated layer index %d", lyr)
+	defer msh.pMutex.Unlock()
+	if err := msh.writeLayerInState(lyr, rewards); err != nil {
+		return err
 	}
 	msh.latestLayerInState = lyr
-	msh.pMutex.Unlock()
+	return nil
 }
 
 func (msh *Mesh) logStateRoot(layerID types.LayerID) {
@@ -498,8 +694,8 @@ func (msh *Mesh) persistRunningLayerHash(layerID types.LayerID, hash types.Hash3
 	}
 }
 
-func (msh *Mesh) getRunningLayerHash(layerID types.LayerID) (types.Hash32, error) {
-	bts, err := msh.general.Get(msh.getRunningLayerHashKey(layerID))
+func (m *DB) getRunningLayerHash(layerID types.LayerID) (types.Hash32, error) {
+	bts, err := m.general.Get(m.getRunningLayerHashKey(layerID))
 	if err != nil {
 		return [32]byte{}, err
 	}
@@ -508,14 +704,18 @@ func (msh *Mesh) getRunningLayerHash(layerID types.LayerID) (types.Hash32, error
 	return hash, nil
 }
 
-func (msh *Mesh) getLayerHashKey(layerID types.LayerID) []byte {
+func (m *DB) getLayerHashKey(layerID types.LayerID) []byte {
 	return []byte(fmt.Sprintf("layerHash_%v", layerID.Bytes()))
 }
 
-func (msh *Mesh) getRunningLayerHashKey(layerID types.LayerID) []byte {
+func (m *DB) getRunningLayerHashKey(layerID types.LayerID) []byte {
 	return []byte(fmt.Sprintf("rLayerHash_%v", layerID.Bytes()))
 }
 
+func (m *DB) getValidBlocksKey(layerID types.LayerID) []byte {
+	return []byte(fmt.Sprintf("validBlocks_%v", layerID.Bytes()))
+}
+
 func (msh *Mesh) extractUniqueOrderedTransactions(l *types.Layer) (validBlockTxs []*types.Transaction) {
 	validBlocks := l.Blocks()
 
@@ -569,14 +769,11 @@ func (msh *Mesh) getTxs(txIds []types.TransactionID, l types.LayerID) []*types.T
 	return txs
 }
 
-func (msh *Mesh) pushTransactions(l *types.Layer) {
+func (msh *Mesh) pushTransactions(l *types.Layer) error {
 	validBlockTxs := msh.extractUniqueOrderedTransactions(l)
 	numFailedTxs, err := msh.ApplyTransactions(l.Index(), validBlockTxs)
 	if err != nil {
-		msh.With().Error("failed to apply transactions",
-			l.Index(), log.Int("num_failed_txs", numFailedTxs), log.Err(err))
-		// TODO: We want to panic here once we have a way to "remember" that we didn't apply these txs
-		//  e.g. persist the last layer transactions were applied from and use that instead of `oldBase`
+		return fmt.Errorf("failed to apply transactions (%d failed): %v", numFailedTxs, err)
 	}
 	msh.removeFromUnappliedTxs(validBlockTxs)
 	msh.With().Info("applied transactions",
@@ -584,6 +781,7 @@ func (msh *Mesh) pushTransactions(l *types.Layer) {
 		l.Index(),
 		log.Int("num_failed_txs", numFailedTxs),
 	)
+	return nil
 }
 
 // GetProcessedLayer returns a layer only if it has already been processed
@@ -772,14 +970,82 @@ func (msh *Mesh) GetOrphanBlocksBefore(l types.LayerID) ([]types.BlockID, error)
 	return idArr, nil
 }
 
-func (msh *Mesh) accumulateRewards(l *types.Layer, params Config) {
-	coinbases := make([]types.Address, 0, len(l.Blocks()))
+// GetSupply returns the scheduled emission of layer rewards as of the layer, according to the reward config, and the
+// amounts actually emitted and burned as of the layer, or as of the latest layer in state if it's earlier, according to
+// the reward ledger.
+func (msh *Mesh) GetSupply(layer types.LayerID) (*types.Supply, error) {
+	supply := msh.schedule.supply(layer, msh.config)
+	supply.EmittedLayer = layer
+	if latest := msh.LatestLayerInState(); latest < layer {
+		supply.EmittedLayer = latest
+	}
+	emitted, burned, err := msh.getEmission(supply.EmittedLayer)
+	if err != nil {
+		return nil, err
+	}
+	if supply.Cap != nil {
+		supply.Remaining = new(big.Int).Sub(supply.Cap, emitted)
+	}
+	supply.Emitted = emitted.Sub(emitted, burned)
+	supply.Burned = burned
+	return supply, nil
+}
+
+// layerRewards are the rewards for the valid blocks of a layer
+type layerRewards struct {
+	// the coinbase, smesher and ID of each rewarded block, in order of block ID
+	coinbases []types.Address
+	smeshers  []string
+	blockIDs  []types.BlockID
 	//the reason we are serializing the types.NodeID to a string instead of using it directly as a
 	//key in the map is due to Golang's restriction on only Comparable types used as map keys. Since
 	//the types.NodeID contains a slice, it is not comparable and hence cannot be used as a map key
 	//TODO: fix this when changing the types.NodeID struct, see https://github.com/spacemeshos/go-spacemesh/issues/2269
-	coinbasesAndSmeshers := make(map[types.Address]map[string]uint64)
-	for _, bl := range l.Blocks() {
+	coinbasesAndSmeshers map[types.Address]map[string]uint64
+	blockTotalReward     *big.Int
+	blockLayerReward     *big.Int
+	layerReward          *big.Int // the layer reward paid out, zero if no block was rewarded
+	remainder            uint64   // the part of the total reward that can't be split evenly among the blocks
+	remainderPolicy      string
+	remainderBlocks      []int // the indexes of the blocks that receive one unit of the remainder each
+}
+
+// burned returns the part of the total reward that isn't paid out to any account.
+func (r *layerRewards) burned() uint64 {
+	if r.remainderPolicy != RemainderBurn {
+		return 0
+	}
+	return r.remainder
+}
+
+// remainderShares returns how many units of the remainder each coinbase and smesher pair receives.
+func (r *layerRewards) remainderShares() map[types.Address]map[string]uint64 {
+	shares := make(map[types.Address]map[string]uint64)
+	for _, i := range r.remainderBlocks {
+		if _, exists := shares[r.coinbases[i]]; !exists {
+			shares[r.coinbases[i]] = make(map[string]uint64)
+		}
+		shares[r.coinbases[i]][r.smeshers[i]]++
+	}
+	return shares
+}
+
+// calculateRewards calculates the rewards for the valid blocks of the layer. If the layer has no blocks that can be
+// rewarded, the rewards have no coinbases. The blocks are rewarded in order of block ID, so that all nodes apply the
+// same rewards in the same order.
+func (msh *Mesh) calculateRewards(l *types.Layer, params Config) *layerRewards {
+	rewards := &layerRewards{
+		coinbases:            make([]types.Address, 0, len(l.Blocks())),
+		smeshers:             make([]string, 0, len(l.Blocks())),
+		blockIDs:             make([]types.BlockID, 0, len(l.Blocks())),
+		coinbasesAndSmeshers: make(map[types.Address]map[string]uint64),
+		blockTotalReward:     &big.Int{},
+		blockLayerReward:     &big.Int{},
+		layerReward:          &big.Int{},
+		remainderPolicy:      params.remainderPolicy(),
+	}
+	blocks := types.SortBlocks(append([]*types.Block(nil), l.Blocks()...))
+	for _, bl := range blocks {
 		if bl.ATXID == *types.EmptyATXID {
 			msh.With().Info("skipping reward distribution for block with no atx", bl.LayerIndex, bl.ID())
 			continue
@@ -789,18 +1055,20 @@ func (msh *Mesh) accumulateRewards(l *types.Layer, params Config) {
 			msh.With().Warning("atx from block not found in db", log.Err(err), bl.ID(), bl.ATXID)
 			continue
 		}
-		coinbases = append(coinbases, atx.Coinbase)
+		rewards.coinbases = append(rewards.coinbases, atx.Coinbase)
+		rewards.smeshers = append(rewards.smeshers, atx.NodeID.String())
+		rewards.blockIDs = append(rewards.blockIDs, bl.ID())
 		//create a 2 dimensional map where the entries are
 		//coinbasesAndSmeshers[coinbase_id][smesher_id] = number of blocks this pair has created
-		if _, exists := coinbasesAndSmeshers[atx.Coinbase]; !exists {
-			coinbasesAndSmeshers[atx.Coinbase] = make(map[string]uint64)
+		if _, exists := rewards.coinbasesAndSmeshers[atx.Coinbase]; !exists {
+			rewards.coinbasesAndSmeshers[atx.Coinbase] = make(map[string]uint64)
 		}
-		coinbasesAndSmeshers[atx.Coinbase][atx.NodeID.String()]++
+		rewards.coinbasesAndSmeshers[atx.Coinbase][atx.NodeID.String()]++
 	}
 
-	if len(coinbases) == 0 {
+	if len(rewards.coinbases) == 0 {
 		msh.With().Info("no valid blocks for layer", l.Index())
-		return
+		return rewards
 	}
 
 	// aggregate all blocks' rewards
@@ -808,41 +1076,81 @@ func (msh *Mesh) accumulateRewards(l *types.Layer, params Config) {
 
 	totalReward := &big.Int{}
 	for _, tx := range txs {
-		totalReward.Add(totalReward, new(big.Int).SetUint64(tx.Fee))
+		fee, ok := tx.GasFee(tx.IntrinsicGas())
+		if !ok {
+			continue // the tx can't be applied, so it doesn't pay any fee
+		}
+		totalReward.Add(totalReward, new(big.Int).SetUint64(fee))
 	}
 
-	layerReward := calculateLayerReward(l.Index(), params)
+	layerReward := msh.schedule.supply(l.Index(), params).LayerReward
 	totalReward.Add(totalReward, layerReward)
-
-	numBlocks := big.NewInt(int64(len(coinbases)))
-
-	blockTotalReward, blockTotalRewardMod := calculateActualRewards(l.Index(), totalReward, numBlocks)
-
-	// NOTE: We don't _report_ rewards when we apply them. This is because applying rewards just requires
-	// the recipient (i.e., coinbase) account, whereas reporting requires knowing the associated smesherid
-	// as well. We report rewards below once we unpack the data structure containing the association between
-	// rewards and smesherids.
-
-	// Applying rewards (here), reporting them, and adding them to the database (below) should be atomic. Right now,
-	// they're not. Also, ApplyRewards does not return an error if it fails.
-	// TODO: fix this.
-	msh.ApplyRewards(l.Index(), coinbases, blockTotalReward)
-
-	blockLayerReward, blockLayerRewardMod := calculateActualRewards(l.Index(), layerReward, numBlocks)
+	rewards.layerReward = layerReward
+
+	numBlocks := big.NewInt(int64(len(rewards.coinbases)))
+
+	var blockTotalRewardMod, blockLayerRewardMod *big.Int
+	rewards.blockTotalReward, blockTotalRewardMod = calculateActualRewards(l.Index(), totalReward, numBlocks)
+	rewards.blockLayerReward, blockLayerRewardMod = calculateActualRewards(l.Index(), layerReward, numBlocks)
+	// the remainder is less than the number of blocks
+	rewards.remainder = blockTotalRewardMod.Uint64()
+	if rewards.remainderPolicy == RemainderRoundRobin {
+		start := l.Index().Uint64() % uint64(len(rewards.coinbases))
+		for i := uint64(0); i < rewards.remainder; i++ {
+			rewards.remainderBlocks = append(rewards.remainderBlocks, int((start+i)%uint64(len(rewards.coinbases))))
+		}
+	}
 	msh.With().Info("reward calculated",
 		l.Index(),
 		log.Uint64("num_blocks", numBlocks.Uint64()),
 		log.Uint64("total_reward", totalReward.Uint64()),
 		log.Uint64("layer_reward", layerReward.Uint64()),
-		log.Uint64("block_total_reward", blockTotalReward.Uint64()),
-		log.Uint64("block_layer_reward", blockLayerReward.Uint64()),
+		log.Uint64("block_total_reward", rewards.blockTotalReward.Uint64()),
+		log.Uint64("block_layer_reward", rewards.blockLayerReward.Uint64()),
 		log.Uint64("total_reward_remainder", blockTotalRewardMod.Uint64()),
 		log.Uint64("layer_reward_remainder", blockLayerRewardMod.Uint64()),
+		log.String("remainder_policy", rewards.remainderPolicy),
 	)
+	return rewards
+}
+
+// accumulateRewards calculates the rewards for the valid blocks of the layer and applies them to the current state,
+// without committing it. The returned rewards are written once the state is committed, by setLatestLayerInState.
+func (msh *Mesh) accumulateRewards(l *types.Layer, params Config) (*layerRewards, error) {
+	rewards := msh.calculateRewards(l, params)
+	if len(rewards.coinbases) == 0 {
+		return rewards, nil
+	}
+	if err := msh.ApplyRewards(l.Index(), rewards.coinbases, rewards.blockTotalReward); err != nil {
+		return nil, fmt.Errorf("failed to apply rewards: %v", err)
+	}
+	if rewards.remainder == 0 {
+		return rewards, nil
+	}
+	switch rewards.remainderPolicy {
+	case RemainderBurn:
+		// the remainder isn't credited to any account, it's only recorded in the reward ledger
+	case RemainderRoundRobin:
+		recipients := make([]types.Address, 0, len(rewards.remainderBlocks))
+		for _, i := range rewards.remainderBlocks {
+			recipients = append(recipients, rewards.coinbases[i])
+		}
+		if err := msh.ApplyRewards(l.Index(), recipients, big.NewInt(1)); err != nil {
+			return nil, fmt.Errorf("failed to apply reward remainder: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown reward remainder policy %q", rewards.remainderPolicy)
+	}
+	return rewards, nil
+}
+
+// reportRewards reports the rewards of a layer that was applied to the state.
+func (msh *Mesh) reportRewards(layer types.LayerID, rewards *layerRewards) {
+	shares := rewards.remainderShares()
 	// Report the rewards for each coinbase and each smesherID within each coinbase.
 	// This can be thought of as a partition of the reward amongst all the smesherIDs
 	// that added the coinbase into the block.
-	for account, smesherAccountEntry := range coinbasesAndSmeshers {
+	for account, smesherAccountEntry := range rewards.coinbasesAndSmeshers {
 		for smesherString, cnt := range smesherAccountEntry {
 			smesherEntry, err := types.StringToNodeID(smesherString)
 			if err != nil {
@@ -850,18 +1158,14 @@ func (msh *Mesh) accumulateRewards(l *types.Layer, params Config) {
 				return
 			}
 			events.ReportRewardReceived(events.Reward{
-				Layer:       l.Index(),
-				Total:       cnt * blockTotalReward.Uint64(),
-				LayerReward: cnt * blockLayerReward.Uint64(),
+				Layer:       layer,
+				Total:       cnt*rewards.blockTotalReward.Uint64() + shares[account][smesherString],
+				LayerReward: cnt * rewards.blockLayerReward.Uint64(),
 				Coinbase:    account,
 				Smesher:     *smesherEntry,
 			})
 		}
 	}
-	if err := msh.writeTransactionRewards(l.Index(), coinbasesAndSmeshers, blockTotalReward, blockLayerReward); err != nil {
-		msh.Error("cannot write reward to db")
-	}
-	// todo: should miner id be sorted in a deterministic order prior to applying rewards?
 }
 
 // GenesisBlock is a is the first static block that xists at the beginning of each network. it exist one layer before actual blocks could be created
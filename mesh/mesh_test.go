@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/events"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/rand"
 	"github.com/spacemeshos/go-spacemesh/signing"
+	"github.com/spacemeshos/go-spacemesh/state"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -75,6 +77,10 @@ func (MockState) LoadState(types.LayerID) error {
 	panic("implement me")
 }
 
+func (MockState) RevertLayers(types.LayerID, types.LayerID, []types.TransactionID) error {
+	panic("implement me")
+}
+
 func (MockState) GetStateRoot() types.Hash32 {
 	return [32]byte{}
 }
@@ -87,11 +93,28 @@ func (MockState) GetLayerApplied(types.TransactionID) *types.LayerID {
 	panic("implement me")
 }
 
+func (MockState) GetReceipt(types.TransactionID) (*types.Receipt, error) {
+	panic("implement me")
+}
+
+func (MockState) GetAccountControl(types.Address) *types.AccountControl {
+	panic("implement me")
+}
+
+func (MockState) GetAccountAtLayer(types.Address, types.LayerID) (*types.AccountState, error) {
+	panic("implement me")
+}
+
+func (MockState) GetAccountProof(types.Address, types.LayerID) (*types.AccountProof, error) {
+	panic("implement me")
+}
+
 func (MockState) ApplyTransactions(types.LayerID, []*types.Transaction) (int, error) {
 	return 0, nil
 }
 
-func (MockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) {
+func (MockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) error {
+	return nil
 }
 
 func (MockState) AddressExists(types.Address) bool {
@@ -149,16 +172,16 @@ func (MockAtxMemPool) Invalidate(types.ATXID) {
 
 }
 
-func getMesh(id string) *Mesh {
+func getMesh(t testing.TB, id string) *Mesh {
 	lg := log.NewDefault(id)
-	mmdb := NewMemMeshDB(lg)
+	mmdb := newTestMeshDB(t, lg)
 	layers := NewMesh(mmdb, NewAtxDbMock(), ConfigTst(), &MeshValidatorMock{mdb: mmdb}, newMockTxMemPool(), &MockState{}, lg)
 	return layers
 }
 
 func TestLayers_AddBlock(t *testing.T) {
 
-	layers := getMesh("t1")
+	layers := getMesh(t, "t1")
 	defer layers.Close()
 
 	block1 := types.NewExistingBlock(1, []byte("data1"), nil)
@@ -208,7 +231,7 @@ func addLayer(id types.LayerID, layerSize int, msh *Mesh) *types.Layer {
 func TestLayers_AddLayer(t *testing.T) {
 	r := require.New(t)
 
-	msh := getMesh("t2")
+	msh := getMesh(t, "t2")
 	defer msh.Close()
 
 	id := types.LayerID(1)
@@ -227,7 +250,7 @@ func TestLayers_AddLayer(t *testing.T) {
 }
 
 func TestLayers_AddWrongLayer(t *testing.T) {
-	layers := getMesh("t3")
+	layers := getMesh(t, "t3")
 	defer layers.Close()
 	block1 := types.NewExistingBlock(1, []byte("data data data1"), nil)
 	block2 := types.NewExistingBlock(2, []byte("data data data2"), nil)
@@ -253,7 +276,7 @@ func TestLayers_AddWrongLayer(t *testing.T) {
 }
 
 func TestLayers_GetLayer(t *testing.T) {
-	layers := getMesh("t4")
+	layers := getMesh(t, "t4")
 	defer layers.Close()
 	block1 := types.NewExistingBlock(1, []byte("data data data1"), nil)
 	block2 := types.NewExistingBlock(1, []byte("data data data2"), nil)
@@ -273,7 +296,7 @@ func TestLayers_GetLayer(t *testing.T) {
 }
 
 func TestLayers_LatestKnownLayer(t *testing.T) {
-	layers := getMesh("t6")
+	layers := getMesh(t, "t6")
 	defer layers.Close()
 	layers.SetLatestLayer(3)
 	layers.SetLatestLayer(7)
@@ -284,7 +307,7 @@ func TestLayers_LatestKnownLayer(t *testing.T) {
 }
 
 func TestLayers_WakeUp(t *testing.T) {
-	layers := getMesh("t1")
+	layers := getMesh(t, "t1")
 	defer layers.Close()
 
 	block1 := types.NewExistingBlock(1, []byte("data1"), nil)
@@ -323,7 +346,7 @@ func TestLayers_WakeUp(t *testing.T) {
 }
 
 func TestLayers_OrphanBlocks(t *testing.T) {
-	layers := getMesh("t6")
+	layers := getMesh(t, "t6")
 	defer layers.Close()
 	block1 := types.NewExistingBlock(1, []byte("data data data1"), nil)
 	block2 := types.NewExistingBlock(1, []byte("data data data2"), nil)
@@ -355,7 +378,7 @@ func TestLayers_OrphanBlocks(t *testing.T) {
 }
 
 func TestLayers_OrphanBlocksClearEmptyLayers(t *testing.T) {
-	layers := getMesh("t6")
+	layers := getMesh(t, "t6")
 	defer layers.Close()
 	block1 := types.NewExistingBlock(1, []byte("data data data1"), nil)
 	block2 := types.NewExistingBlock(1, []byte("data data data2"), nil)
@@ -386,7 +409,7 @@ func TestLayers_OrphanBlocksClearEmptyLayers(t *testing.T) {
 func TestMesh_AddBlockWithTxs_PushTransactions_UpdateUnappliedTxs(t *testing.T) {
 	r := require.New(t)
 
-	msh := getMesh("mesh")
+	msh := getMesh(t, "mesh")
 
 	state := &MockMapState{}
 	msh.txProcessor = state
@@ -419,10 +442,120 @@ func TestMesh_AddBlockWithTxs_PushTransactions_UpdateUnappliedTxs(t *testing.T)
 	r.Empty(txns)
 }
 
+func TestMesh_pushLayersToState_Reorg(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(events.InitializeEventReporterWithOptions("", 10, true))
+	defer events.CloseEventReporter()
+
+	msh := getMesh(t, "reorg")
+	defer msh.Close()
+	msh.txProcessor = &MockMapState{}
+
+	layerID := types.GetEffectiveGenesis() + 1
+	signer, _ := newSignerAndAddress(r, "origin")
+	tx1 := addTxToMesh(r, msh, signer, 2468)
+	tx2 := addTxToMesh(r, msh, signer, 2469)
+	blk1 := addBlockWithTxs(r, msh, layerID, true, tx1)
+	blk2 := addBlockWithTxs(r, msh, layerID, true, tx2)
+
+	// the valid blocks of a layer are first reported when it's applied, which isn't a reorg
+	msh.pushLayersToState(layerID, layerID+1)
+	select {
+	case reorg := <-events.GetReorgChannel():
+		r.Fail("unexpected reorg", reorg)
+	default:
+	}
+
+	r.NoError(msh.SaveContextualValidity(blk2.ID(), false))
+	msh.pushLayersToState(layerID, layerID+1)
+	select {
+	case reorg := <-events.GetReorgChannel():
+		r.Len(reorg.Layers, 1)
+		r.Equal(layerID, reorg.Layers[0].Layer)
+		r.ElementsMatch([]types.BlockID{blk1.ID(), blk2.ID()}, reorg.Layers[0].OldValid)
+		r.Equal([]types.BlockID{blk1.ID()}, reorg.Layers[0].NewValid)
+	default:
+		r.Fail("no reorg reported")
+	}
+
+	// the same validity doesn't report another reorg
+	msh.pushLayersToState(layerID, layerID+1)
+	select {
+	case reorg := <-events.GetReorgChannel():
+		r.Fail("unexpected reorg", reorg)
+	default:
+	}
+}
+
+func TestMesh_pushLayersToState_ReorgRevertsState(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(events.InitializeEventReporterWithOptions("", 100, true))
+	defer events.CloseEventReporter()
+
+	lg := log.NewDefault("reorg")
+	mdb := newTestMeshDB(t, lg)
+	atxDB := NewAtxDbMock()
+	proc := state.NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), mdb, state.NewTxMemPool(), lg)
+	msh := NewMesh(mdb, atxDB, Config{BaseReward: big.NewInt(1000)}, &MeshValidatorMock{mdb: mdb}, newMockTxMemPool(), proc, lg)
+	defer msh.Close()
+
+	// the two blocks of each layer are rewarded to the same two coinbases
+	first := types.GetEffectiveGenesis() + 1
+	var flipped types.BlockID
+	for l := first; l <= first+2; l++ {
+		_, blocks := createLayer(t, msh, l, 2, 1, atxDB)
+		for _, b := range blocks {
+			r.NoError(msh.SaveContextualValidity(b.ID(), true))
+		}
+		if l == first+1 {
+			flipped = types.SortBlocks(blocks)[1].ID()
+		}
+	}
+	msh.pushLayersToState(first, first+3)
+	r.Equal(first+2, msh.LatestLayerInState())
+	coinbases := []types.Address{types.HexToAddress("0"), types.HexToAddress("1")}
+	for _, coinbase := range coinbases {
+		r.Equal(uint64(1500), proc.GetBalance(coinbase))
+	}
+
+	// the layer after the one whose valid blocks changed is applied again too, before the reorg is reported
+	atx, err := atxDB.GetAtxHeader(mustGetBlock(r, msh, flipped).ATXID)
+	r.NoError(err)
+	invalid := atx.Coinbase
+	r.NoError(msh.SaveContextualValidity(flipped, false))
+	msh.pushLayersToState(first+1, first+2)
+	select {
+	case reorg := <-events.GetReorgChannel():
+		r.Len(reorg.Layers, 1)
+		r.Equal(first+1, reorg.Layers[0].Layer)
+	default:
+		r.Fail("no reorg reported")
+	}
+	r.Equal(first+2, msh.LatestLayerInState())
+	for _, coinbase := range coinbases {
+		if coinbase == invalid {
+			r.Equal(uint64(1000), proc.GetBalance(coinbase))
+		} else {
+			r.Equal(uint64(2000), proc.GetBalance(coinbase))
+		}
+	}
+	ledger, err := msh.getRewardLedger(first + 1)
+	r.NoError(err)
+	r.Len(ledger.Entries, 1)
+}
+
+func mustGetBlock(r *require.Assertions, msh *Mesh, id types.BlockID) *types.Block {
+	b, err := msh.GetBlock(id)
+	r.NoError(err)
+	return b
+}
+
 func TestMesh_AddBlockWithTxs_PushTransactions_getInvalidBlocksByHare(t *testing.T) {
 	r := require.New(t)
 
-	msh := getMesh("mesh")
+	msh := getMesh(t, "mesh")
 
 	state := &MockMapState{}
 	msh.txProcessor = state
@@ -449,7 +582,7 @@ func TestMesh_AddBlockWithTxs_PushTransactions_getInvalidBlocksByHare(t *testing
 func TestMesh_ExtractUniqueOrderedTransactions(t *testing.T) {
 	r := require.New(t)
 
-	msh := getMesh("t2")
+	msh := getMesh(t, "t2")
 	defer msh.Close()
 	layerID := types.LayerID(1)
 	signer, _ := newSignerAndAddress(r, "origin")
@@ -469,7 +602,7 @@ func TestMesh_ExtractUniqueOrderedTransactions(t *testing.T) {
 }
 
 func TestMesh_persistLayerHashes(t *testing.T) {
-	msh := getMesh("persistLayerHashes")
+	msh := getMesh(t, "persistLayerHashes")
 	defer msh.Close()
 
 	// test first layer hash
@@ -531,10 +664,14 @@ func (FailingAtxDbMock) GetFullAtx(types.ATXID) (*types.ActivationTx, error) { p
 
 func (FailingAtxDbMock) SyntacticallyValidateAtx(*types.ActivationTx) error { panic("implement me") }
 
+func (FailingAtxDbMock) GetCoinbaseAtxIDs(types.Address) ([]types.ATXID, error) {
+	panic("implement me")
+}
+
 func TestMesh_AddBlockWithTxs(t *testing.T) {
 	r := require.New(t)
 	lg := log.NewDefault("id")
-	meshDB := NewMemMeshDB(lg)
+	meshDB := newTestMeshDB(t, lg)
 	mesh := NewMesh(meshDB, &FailingAtxDbMock{}, ConfigTst(), &MeshValidatorMock{mdb: meshDB}, newMockTxMemPool(), &MockState{}, lg)
 
 	blk := types.NewExistingBlock(1, []byte("data"), nil)
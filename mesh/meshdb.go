@@ -1,10 +1,13 @@
 package mesh
 
 import (
+	"bytes"
 	"container/list"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"path/filepath"
 	"strconv"
@@ -12,9 +15,11 @@ import (
 	"sync"
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/common/util"
 	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/pendingtxs"
+	"github.com/spacemeshos/go-spacemesh/signing"
 )
 
 type layerMutex struct {
@@ -39,36 +44,45 @@ type DB struct {
 	layerMutex            map[types.LayerID]*layerMutex
 	lhMutex               sync.Mutex
 	InputVectorBackupFunc func(id types.LayerID) ([]types.BlockID, error)
-	exit                  chan struct{}
+	// LayerRetention, if not 0, is the number of layers before the latest layer in state whose blocks and
+	// transactions are kept. Those of older layers are pruned as layers are applied to the state.
+	LayerRetention uint32
+	prunedLayer    types.LayerID
+	prunedMutex    sync.RWMutex
+	// ExplorerIndex enables the secondary indexes of the blocks that include each transaction and of the blocks produced
+	// by each smesher. Blocks are indexed when they're written while it's set, and BuildExplorerIndex indexes those
+	// written before it was set.
+	ExplorerIndex bool
+	exit          chan struct{}
 }
 
-// NewPersistentMeshDB creates an instance of a mesh database
-func NewPersistentMeshDB(path string, blockCacheSize int, log log.Log) (*DB, error) {
-	bdb, err := database.NewLDBDatabase(filepath.Join(path, "blocks"), 0, 0, log)
+// NewPersistentMeshDB creates an instance of a mesh database whose databases are stored with the given backend
+func NewPersistentMeshDB(path, backend string, blockCacheSize int, log log.Log) (*DB, error) {
+	bdb, err := database.NewPersistentDatabase(backend, filepath.Join(path, "blocks"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize blocks db: %v", err)
 	}
-	ldb, err := database.NewLDBDatabase(filepath.Join(path, "layers"), 0, 0, log)
+	ldb, err := database.NewPersistentDatabase(backend, filepath.Join(path, "layers"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize layers db: %v", err)
 	}
-	vdb, err := database.NewLDBDatabase(filepath.Join(path, "validity"), 0, 0, log)
+	vdb, err := database.NewPersistentDatabase(backend, filepath.Join(path, "validity"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize validity db: %v", err)
 	}
-	tdb, err := database.NewLDBDatabase(filepath.Join(path, "transactions"), 0, 0, log)
+	tdb, err := database.NewPersistentDatabase(backend, filepath.Join(path, "transactions"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize transactions db: %v", err)
 	}
-	gdb, err := database.NewLDBDatabase(filepath.Join(path, "general"), 0, 0, log)
+	gdb, err := database.NewPersistentDatabase(backend, filepath.Join(path, "general"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize general db: %v", err)
 	}
-	utx, err := database.NewLDBDatabase(filepath.Join(path, "unappliedTxs"), 0, 0, log)
+	utx, err := database.NewPersistentDatabase(backend, filepath.Join(path, "unappliedTxs"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize mesh unappliedTxs db: %v", err)
 	}
-	iv, err := database.NewLDBDatabase(filepath.Join(path, "inputvector"), 0, 0, log)
+	iv, err := database.NewPersistentDatabase(backend, filepath.Join(path, "inputvector"), 0, 0, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize mesh unappliedTxs db: %v", err)
 	}
@@ -87,6 +101,9 @@ func NewPersistentMeshDB(path string, blockCacheSize int, log log.Log) (*DB, err
 		layerMutex:         make(map[types.LayerID]*layerMutex),
 		exit:               make(chan struct{}),
 	}
+	if pruned, err := gdb.Get(constPRUNED); err == nil {
+		ll.prunedLayer = types.LayerID(util.BytesToUint64(pruned))
+	}
 
 	for _, blk := range GenesisLayer().Blocks() {
 		ll.Log.With().Info("Adding genesis block ", blk.ID(), blk.LayerIndex)
@@ -100,6 +117,50 @@ func NewPersistentMeshDB(path string, blockCacheSize int, log log.Log) (*DB, err
 	return ll, nil
 }
 
+// NewReadOnlyMeshDB opens an existing mesh database without changing it, for the tools that inspect the mesh of a
+// node that isn't running. Writes to it fail.
+func NewReadOnlyMeshDB(path string, blockCacheSize int, log log.Log) (*DB, error) {
+	names := []string{"blocks", "layers", "validity", "transactions", "general", "unappliedTxs", "inputvector"}
+	dbs := make([]database.Database, 0, len(names))
+	for _, name := range names {
+		db, err := database.NewReadOnlyDatabase(filepath.Join(path, name), log)
+		if err != nil {
+			for _, db := range dbs {
+				db.Close()
+			}
+			return nil, fmt.Errorf("failed to open %v db: %v", name, err)
+		}
+		dbs = append(dbs, db)
+	}
+	ll := &DB{
+		Log:                log,
+		blockCache:         newBlockCache(blockCacheSize * layerSize),
+		blocks:             dbs[0],
+		layers:             dbs[1],
+		contextualValidity: dbs[2],
+		transactions:       dbs[3],
+		general:            dbs[4],
+		unappliedTxs:       dbs[5],
+		inputVector:        dbs[6],
+		orphanBlocks:       make(map[types.LayerID]map[types.BlockID]struct{}),
+		layerMutex:         make(map[types.LayerID]*layerMutex),
+		exit:               make(chan struct{}),
+	}
+	if pruned, err := ll.general.Get(constPRUNED); err == nil {
+		ll.prunedLayer = types.LayerID(util.BytesToUint64(pruned))
+	}
+	return ll, nil
+}
+
+// StoredProcessedLayer returns the last layer handled by the tortoise, as recorded in the database.
+func (m *DB) StoredProcessedLayer() (types.LayerID, error) {
+	processed, err := m.general.Get(constPROCESSED)
+	if err != nil {
+		return 0, err
+	}
+	return types.LayerID(util.BytesToUint64(processed)), nil
+}
+
 // PersistentData checks to see if db is empty
 func (m *DB) PersistentData() bool {
 	if _, err := m.general.Get(constLATEST); err == nil {
@@ -148,6 +209,9 @@ func (m *DB) Close() {
 // ErrAlreadyExist error returned when adding an existing value to the database
 var ErrAlreadyExist = errors.New("block already exists in database")
 
+// ErrLayerPruned is returned when reading the blocks of a layer that was pruned
+var ErrLayerPruned = errors.New("layer was pruned")
+
 // AddBlock adds a block to the database
 func (m *DB) AddBlock(bl *types.Block) error {
 	m.blockMutex.Lock()
@@ -180,6 +244,9 @@ func (m *DB) GetBlock(id types.BlockID) (*types.Block, error) {
 
 // LayerBlocks retrieves all blocks from a layer by layer index
 func (m *DB) LayerBlocks(index types.LayerID) ([]*types.Block, error) {
+	if pruned := m.PrunedLayer(); pruned > 0 && index <= pruned {
+		return nil, ErrLayerPruned
+	}
 	ids, err := m.LayerBlockIds(index)
 	if err != nil {
 		return nil, err
@@ -328,6 +395,32 @@ func (m *DB) GetLayerInputVector(lyrid types.LayerID) ([]types.BlockID, error) {
 	return m.defaulGetLayerInputVector(lyrid)
 }
 
+func getHealedVectorKey(lyrid types.LayerID) []byte {
+	return append([]byte("h"), lyrid.Bytes()...)
+}
+
+// SaveLayerHealedVector saves the blocks the full tortoise found valid in a layer the verifying tortoise couldn't
+// verify. It's kept apart from the layer's input vector, which remains the hare's result.
+func (m *DB) SaveLayerHealedVector(lyrid types.LayerID, vector []types.BlockID) error {
+	bytes, err := types.InterfaceToBytes(vector)
+	if err != nil {
+		return err
+	}
+	return m.inputVector.Put(getHealedVectorKey(lyrid), bytes)
+}
+
+// GetLayerHealedVector gets the blocks the full tortoise found valid in a layer, it returns database.ErrNotFound if the
+// full tortoise didn't verify the layer
+func (m *DB) GetLayerHealedVector(lyrid types.LayerID) ([]types.BlockID, error) {
+	by, err := m.inputVector.Get(getHealedVectorKey(lyrid))
+	if err != nil {
+		return nil, err
+	}
+	var v []types.BlockID
+	err = types.BytesToInterface(by, &v)
+	return v, err
+}
+
 func (m *DB) writeBlock(bl *types.Block) error {
 	bytes, err := types.InterfaceToBytes(bl)
 	if err != nil {
@@ -338,6 +431,12 @@ func (m *DB) writeBlock(bl *types.Block) error {
 		return fmt.Errorf("could not add bl %v to database %v", bl.ID(), err)
 	}
 
+	if m.ExplorerIndex {
+		if err := m.writeBlockIndexes(bl); err != nil {
+			return fmt.Errorf("could not index bl %v %v", bl.ID(), err)
+		}
+	}
+
 	m.updateLayerWithBlock(bl)
 
 	m.blockCache.put(bl)
@@ -345,6 +444,112 @@ func (m *DB) writeBlock(bl *types.Block) error {
 	return nil
 }
 
+// writeBlockIndexes indexes the block by the transactions it includes and by the smesher that produced it
+func (m *DB) writeBlockIndexes(bl *types.Block) error {
+	id, err := types.InterfaceToBytes(bl.ID())
+	if err != nil {
+		return err
+	}
+	batch := m.transactions.NewBatch()
+	for _, txID := range bl.TxIDs {
+		if err := batch.Put(getTransactionBlockKey(txID, bl.ID()), id); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	if bl.MinerID() == nil {
+		return nil
+	}
+	return m.blocks.Put(getSmesherBlockKey(bl.MinerID(), bl.Layer(), bl.ID()), id)
+}
+
+// explorerIndexKey is set in the general db while the explorer indexes cover all the stored blocks
+var explorerIndexKey = []byte("explorer index")
+
+// BuildExplorerIndex indexes the stored blocks if ExplorerIndex is set and the indexes don't cover them yet, e.g.
+// because it was just set on an existing data dir. If ExplorerIndex isn't set, the indexes are marked as incomplete,
+// since the blocks written from now on aren't indexed, so they're built again once it's set.
+func (m *DB) BuildExplorerIndex() error {
+	if !m.ExplorerIndex {
+		return m.general.Delete(explorerIndexKey)
+	}
+	if built, err := m.general.Has(explorerIndexKey); err != nil || built {
+		return err
+	}
+	var indexed int
+	it := m.blocks.Find(nil)
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		// the blocks db also holds the smesher index, whose keys are longer than block IDs
+		if len(it.Key()) != len(types.Hash32{}) {
+			continue
+		}
+		block := &types.Block{}
+		if err := types.BytesToInterface(it.Value(), block); err != nil {
+			it.Release()
+			return fmt.Errorf("block %x can't be decoded: %v", it.Key(), err)
+		}
+		block.Initialize()
+		if err := m.writeBlockIndexes(block); err != nil {
+			it.Release()
+			return fmt.Errorf("could not index block %v: %v", block.ID(), err)
+		}
+		indexed++
+	}
+	err := it.Error()
+	it.Release()
+	if err != nil {
+		return fmt.Errorf("failed to read blocks: %v", err)
+	}
+	m.With().Info("built explorer index of stored blocks", log.Int("num_blocks", indexed))
+	return m.general.Put(explorerIndexKey, constTrue)
+}
+
+// ErrIndexDisabled is returned when querying a secondary index that isn't maintained
+var ErrIndexDisabled = errors.New("explorer index is disabled")
+
+// GetTransactionBlocks returns the IDs of the blocks that include the transaction, or ErrIndexDisabled if ExplorerIndex
+// isn't set.
+func (m *DB) GetTransactionBlocks(txID types.TransactionID) ([]types.BlockID, error) {
+	if !m.ExplorerIndex {
+		return nil, ErrIndexDisabled
+	}
+	return findBlockIDs(m.transactions, getTransactionBlockKeyPrefix(txID))
+}
+
+// GetSmesherBlocks returns the IDs of the blocks produced by the smesher with the given public key, ordered by layer, or
+// ErrIndexDisabled if ExplorerIndex isn't set.
+func (m *DB) GetSmesherBlocks(smesher *signing.PublicKey) ([]types.BlockID, error) {
+	if !m.ExplorerIndex {
+		return nil, ErrIndexDisabled
+	}
+	return findBlockIDs(m.blocks, getSmesherBlockKeyPrefix(smesher))
+}
+
+func findBlockIDs(db database.Database, prefix []byte) ([]types.BlockID, error) {
+	var ids []types.BlockID
+	it := db.Find(prefix)
+	defer it.Release()
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		var id types.BlockID
+		if err := types.BytesToInterface(it.Value(), &id); err != nil {
+			return nil, fmt.Errorf("could not decode block id: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("could not read block ids: %v", err)
+	}
+	return ids, nil
+}
+
 func (m *DB) updateLayerWithBlock(blk *types.Block) error {
 	lm := m.getLayerMutex(blk.LayerIndex)
 	defer m.endLayerWorker(blk.LayerIndex)
@@ -424,13 +629,33 @@ func getSmesherRewardKeyPrefix(smesherID types.NodeID) []byte {
 	return []byte(str)
 }
 
+func getTransactionBlockKey(txID types.TransactionID, blockID types.BlockID) []byte {
+	return append(getTransactionBlockKeyPrefix(txID), blockID.Bytes()...)
+}
+
+func getTransactionBlockKeyPrefix(txID types.TransactionID) []byte {
+	str := "b_t_" + txID.String() + "_"
+	return []byte(str)
+}
+
+// the layer is encoded in big endian, so the blocks of a smesher are iterated in layer order
+func getSmesherBlockKey(smesher *signing.PublicKey, l types.LayerID, blockID types.BlockID) []byte {
+	key := append(getSmesherBlockKeyPrefix(smesher), util.Uint64ToBytesBigEndian(l.Uint64())...)
+	return append(key, blockID.Bytes()...)
+}
+
+func getSmesherBlockKeyPrefix(smesher *signing.PublicKey) []byte {
+	str := "b_s_" + smesher.String() + "_"
+	return []byte(str)
+}
+
 func getTransactionOriginKey(l types.LayerID, t *types.Transaction) []byte {
 	str := string(getTransactionOriginKeyPrefix(l, t.Origin())) + "_" + t.ID().String()
 	return []byte(str)
 }
 
-func getTransactionDestKey(l types.LayerID, t *types.Transaction) []byte {
-	str := string(getTransactionDestKeyPrefix(l, t.Recipient)) + "_" + t.ID().String()
+func getTransactionDestKey(l types.LayerID, recipient types.Address, t *types.Transaction) []byte {
+	str := string(getTransactionDestKeyPrefix(l, recipient)) + "_" + t.ID().String()
 	return []byte(str)
 }
 
@@ -444,6 +669,8 @@ func getTransactionDestKeyPrefix(l types.LayerID, account types.Address) []byte
 	return []byte(str)
 }
 
+// dbTransaction is a transaction as stored in the database, along with its origin. It's encoded the way XDR encodes
+// a struct of a *types.Transaction followed by the origin, which is how transfers have always been stored.
 type dbTransaction struct {
 	*types.Transaction
 	Origin types.Address
@@ -458,10 +685,42 @@ func (t dbTransaction) getTransaction() *types.Transaction {
 	return t.Transaction
 }
 
+func (t dbTransaction) bytes() ([]byte, error) {
+	present, err := types.InterfaceToBytes(true)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := types.TransactionToBytes(t.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(present, txBytes...), t.Origin.Bytes()...), nil
+}
+
+func bytesToDbTransaction(buf []byte) (*dbTransaction, error) {
+	r := bytes.NewReader(buf)
+	var present [4]byte // the XDR bool that says the *types.Transaction isn't nil
+	if _, err := io.ReadFull(r, present[:]); err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(present[:]) != 1 {
+		return nil, errors.New("missing transaction")
+	}
+	tx, err := types.DecodeTransaction(r)
+	if err != nil {
+		return nil, err
+	}
+	t := &dbTransaction{Transaction: tx}
+	if _, err := io.ReadFull(r, t.Origin[:]); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
 func (m *DB) writeTransactions(l types.LayerID, txs []*types.Transaction) error {
 	batch := m.transactions.NewBatch()
 	for _, t := range txs {
-		bytes, err := types.InterfaceToBytes(newDbTransaction(t))
+		bytes, err := newDbTransaction(t).bytes()
 		if err != nil {
 			return fmt.Errorf("could not marshall tx %v to bytes: %v", t.ID().ShortString(), err)
 		}
@@ -472,8 +731,10 @@ func (m *DB) writeTransactions(l types.LayerID, txs []*types.Transaction) error
 		if err := batch.Put(getTransactionOriginKey(l, t), t.ID().Bytes()); err != nil {
 			return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
 		}
-		if err := batch.Put(getTransactionDestKey(l, t), t.ID().Bytes()); err != nil {
-			return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
+		for _, transfer := range t.Transfers() {
+			if err := batch.Put(getTransactionDestKey(l, transfer.Recipient, t), t.ID().Bytes()); err != nil {
+				return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
+			}
 		}
 		m.Debug("wrote tx %v to db", t.ID().ShortString())
 	}
@@ -486,7 +747,7 @@ func (m *DB) writeTransactions(l types.LayerID, txs []*types.Transaction) error
 
 // WriteTransaction writes a single transaction to the db
 func (m *DB) WriteTransaction(l types.LayerID, t *types.Transaction) error {
-	bytes, err := types.InterfaceToBytes(newDbTransaction(t))
+	bytes, err := newDbTransaction(t).bytes()
 	if err != nil {
 		return fmt.Errorf("could not marshall tx %v to bytes: %v", t.ID().ShortString(), err)
 	}
@@ -497,14 +758,116 @@ func (m *DB) WriteTransaction(l types.LayerID, t *types.Transaction) error {
 	if err := m.transactions.Put(getTransactionOriginKey(l, t), t.ID().Bytes()); err != nil {
 		return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
 	}
-	if err := m.transactions.Put(getTransactionDestKey(l, t), t.ID().Bytes()); err != nil {
-		return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
+	for _, transfer := range t.Transfers() {
+		if err := m.transactions.Put(getTransactionDestKey(l, transfer.Recipient, t), t.ID().Bytes()); err != nil {
+			return fmt.Errorf("could not write tx %v to database: %v", t.ID().ShortString(), err)
+		}
 	}
 	m.Debug("wrote tx %v to db", t.ID().ShortString())
 
 	return nil
 }
 
+// PrunedLayer returns the latest layer whose blocks and transactions were pruned, or 0 if none were. The genesis layer
+// is never pruned.
+func (m *DB) PrunedLayer() types.LayerID {
+	m.prunedMutex.RLock()
+	defer m.prunedMutex.RUnlock()
+	return m.prunedLayer
+}
+
+// pruneLayers deletes the blocks of the layers after the latest pruned layer, up to and including the given layer, along
+// with the transactions that were applied to the state by then. The layers' block IDs, hashes, contextual validity and
+// input vectors are kept, as well as the rewards. txApplied returns the layer in which a transaction was applied, or nil
+// if it wasn't.
+func (m *DB) pruneLayers(to types.LayerID, txApplied func(types.TransactionID) *types.LayerID) error {
+	m.prunedMutex.Lock()
+	defer m.prunedMutex.Unlock()
+	for l := m.prunedLayer + 1; l <= to; l++ {
+		if err := m.pruneLayer(l, to, txApplied); err != nil {
+			return fmt.Errorf("failed to prune layer %v: %v", l, err)
+		}
+		if err := m.general.Put(constPRUNED, l.Bytes()); err != nil {
+			return fmt.Errorf("failed to persist pruned layer %v: %v", l, err)
+		}
+		m.prunedLayer = l
+	}
+	return nil
+}
+
+func (m *DB) pruneLayer(l, to types.LayerID, txApplied func(types.TransactionID) *types.LayerID) error {
+	ids, err := m.LayerBlockIds(l)
+	if err == database.ErrNotFound {
+		return nil // no blocks were received for the layer
+	}
+	if err != nil {
+		return err
+	}
+	blockBatch := m.blocks.NewBatch()
+	txBatch := m.transactions.NewBatch()
+	for _, id := range ids {
+		block, err := m.GetBlock(id)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not read block %v: %v", id, err)
+		}
+		for _, txID := range block.TxIDs {
+			// a transaction that wasn't applied yet may still be needed by a block in a later layer
+			if applied := txApplied(txID); applied == nil || *applied > to {
+				continue
+			}
+			tx, err := m.GetTransaction(txID)
+			if err != nil {
+				continue // already pruned with another block
+			}
+			if err := txBatch.Delete(txID.Bytes()); err != nil {
+				return fmt.Errorf("could not delete tx %v: %v", txID.ShortString(), err)
+			}
+			if err := txBatch.Delete(getTransactionOriginKey(l, tx)); err != nil {
+				return fmt.Errorf("could not delete tx %v: %v", txID.ShortString(), err)
+			}
+			for _, transfer := range tx.Transfers() {
+				if err := txBatch.Delete(getTransactionDestKey(l, transfer.Recipient, tx)); err != nil {
+					return fmt.Errorf("could not delete tx %v: %v", txID.ShortString(), err)
+				}
+			}
+		}
+		if err := blockBatch.Delete(id.Bytes()); err != nil {
+			return fmt.Errorf("could not delete block %v: %v", id, err)
+		}
+		if err := m.deleteBlockIndexes(block, blockBatch, txBatch); err != nil {
+			return fmt.Errorf("could not delete indexes of block %v: %v", id, err)
+		}
+	}
+	if err := txBatch.Write(); err != nil {
+		return fmt.Errorf("failed to delete transactions: %v", err)
+	}
+	if err := blockBatch.Write(); err != nil {
+		return fmt.Errorf("failed to delete blocks: %v", err)
+	}
+	for _, id := range ids {
+		m.blockCache.remove(id)
+	}
+	m.With().Info("pruned layer", l, log.Int("num_blocks", len(ids)))
+	return nil
+}
+
+// deleteBlockIndexes adds the deletion of the block's secondary index entries to the batches. They're deleted even if
+// ExplorerIndex isn't set, since the block may have been indexed before it was unset.
+func (m *DB) deleteBlockIndexes(bl *types.Block, blockBatch, txBatch database.Batch) error {
+	for _, txID := range bl.TxIDs {
+		if err := txBatch.Delete(getTransactionBlockKey(txID, bl.ID())); err != nil {
+			return err
+		}
+	}
+	if bl.MinerID() == nil {
+		return nil
+	}
+	return blockBatch.Delete(getSmesherBlockKey(bl.MinerID(), bl.Layer(), bl.ID()))
+}
+
 //We're not using the existing reward type because the layer is implicit in the key
 type dbReward struct {
 	TotalReward         uint64
@@ -514,15 +877,54 @@ type dbReward struct {
 	// TotalReward - LayerRewardEstimate = FeesEstimate
 }
 
-func (m *DB) writeTransactionRewards(l types.LayerID, accountBlockCount map[types.Address]map[string]uint64, totalReward, layerReward *big.Int) error {
-	batch := m.transactions.NewBatch()
-	for account, smesherAccountEntry := range accountBlockCount {
+// rewardLedgerEntry is a coinbase and smesher pair that was rewarded in a layer
+type rewardLedgerEntry struct {
+	Coinbase  types.Address
+	SmesherID types.NodeID
+}
+
+// rewardLedger records what was rewarded in a layer
+type rewardLedger struct {
+	Entries         []rewardLedgerEntry
+	Remainder       uint64          // the part of the total reward that couldn't be split evenly among the blocks
+	RemainderPolicy string          // how the remainder was distributed
+	RemainderBlocks []types.BlockID // the blocks that received one unit of the remainder each, if it wasn't burned
+	LayerReward     []byte          // the layer reward paid out, as a big-endian integer
+	Emitted         []byte          // the total layer reward paid out up to and including the layer, as a big-endian integer
+	Burned          []byte          // the total remainder burned up to and including the layer, as a big-endian integer
+}
+
+// Schema: "rl_<layerId> -> the reward ledger of the layer". Every layer whose rewards were written has an entry, even if
+// nothing was rewarded in it, so they can be told apart from layers whose rewards weren't.
+func getRewardLedgerKey(l types.LayerID) []byte {
+	return []byte("rl_" + strconv.FormatUint(l.Uint64(), 10))
+}
+
+// putTransactionRewards adds the rewards of a layer, along with the layer's reward ledger entry, to the batch. emitted and
+// burned are the total layer reward paid out and the total remainder burned up to and including the layer.
+func putTransactionRewards(batch database.Putter, l types.LayerID, rewards *layerRewards, emitted, burned *big.Int) error {
+	ledger := rewardLedger{
+		Entries:         []rewardLedgerEntry{},
+		Remainder:       rewards.remainder,
+		RemainderPolicy: rewards.remainderPolicy,
+		RemainderBlocks: []types.BlockID{},
+		LayerReward:     rewards.layerReward.Bytes(),
+		Emitted:         emitted.Bytes(),
+		Burned:          burned.Bytes(),
+	}
+	for _, i := range rewards.remainderBlocks {
+		ledger.RemainderBlocks = append(ledger.RemainderBlocks, rewards.blockIDs[i])
+	}
+	shares := rewards.remainderShares()
+	totalReward, layerReward := rewards.blockTotalReward.Uint64(), rewards.blockLayerReward.Uint64()
+	for account, smesherAccountEntry := range rewards.coinbasesAndSmeshers {
 		for smesherString, cnt := range smesherAccountEntry {
 			smesherEntry, err := types.StringToNodeID(smesherString)
 			if err != nil {
 				return fmt.Errorf("could not convert String to NodeID for %v: %v", smesherString, err)
 			}
-			reward := dbReward{TotalReward: cnt * totalReward.Uint64(), LayerRewardEstimate: cnt * layerReward.Uint64(), SmesherID: *smesherEntry, Coinbase: account}
+			ledger.Entries = append(ledger.Entries, rewardLedgerEntry{Coinbase: account, SmesherID: *smesherEntry})
+			reward := dbReward{TotalReward: cnt*totalReward + shares[account][smesherString], LayerRewardEstimate: cnt * layerReward, SmesherID: *smesherEntry, Coinbase: account}
 			if b, err := types.InterfaceToBytes(&reward); err != nil {
 				return fmt.Errorf("could not marshal reward for %v: %v", account.Short(), err)
 			} else if err := batch.Put(getRewardKey(l, account, *smesherEntry), b); err != nil {
@@ -532,6 +934,117 @@ func (m *DB) writeTransactionRewards(l types.LayerID, accountBlockCount map[type
 			}
 		}
 	}
+	b, err := types.InterfaceToBytes(&ledger)
+	if err != nil {
+		return fmt.Errorf("could not marshal reward ledger of layer %v: %v", l, err)
+	}
+	if err := batch.Put(getRewardLedgerKey(l), b); err != nil {
+		return fmt.Errorf("could not write reward ledger of layer %v to database: %v", l, err)
+	}
+	return nil
+}
+
+// getRewardLedger returns the reward ledger of the layer, or database.ErrNotFound if its rewards weren't written.
+func (m *DB) getRewardLedger(l types.LayerID) (*rewardLedger, error) {
+	b, err := m.transactions.Get(getRewardLedgerKey(l))
+	if err != nil {
+		return nil, err
+	}
+	var ledger rewardLedger
+	if err := types.BytesToInterface(b, &ledger); err != nil {
+		return nil, fmt.Errorf("could not unmarshal reward ledger of layer %v: %v", l, err)
+	}
+	return &ledger, nil
+}
+
+// getEmission returns the total layer reward paid out and the total remainder burned up to and including the layer,
+// according to its reward ledger. Both are zero if the layer has no reward ledger, since that means no layer up to it was
+// applied to the state.
+func (m *DB) getEmission(l types.LayerID) (emitted, burned *big.Int, err error) {
+	ledger, err := m.getRewardLedger(l)
+	if err == database.ErrNotFound {
+		return new(big.Int), new(big.Int), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return new(big.Int).SetBytes(ledger.Emitted), new(big.Int).SetBytes(ledger.Burned), nil
+}
+
+// hasTransactionRewards returns true if the rewards of the layer were written.
+func (m *DB) hasTransactionRewards(l types.LayerID) (bool, error) {
+	return m.transactions.Has(getRewardLedgerKey(l))
+}
+
+// deleteTransactionRewards adds the deletion of the rewards of a layer, along with its reward ledger entry, to the
+// batch. It does nothing if the layer's rewards weren't written.
+func (m *DB) deleteTransactionRewards(batch database.Deleter, l types.LayerID) error {
+	ledger, err := m.getRewardLedger(l)
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read reward ledger of layer %v: %v", l, err)
+	}
+	for _, entry := range ledger.Entries {
+		if err := batch.Delete(getRewardKey(l, entry.Coinbase, entry.SmesherID)); err != nil {
+			return fmt.Errorf("could not delete reward of %v: %v", entry.Coinbase.Short(), err)
+		}
+		if err := batch.Delete(getSmesherRewardKey(l, entry.SmesherID, entry.Coinbase)); err != nil {
+			return fmt.Errorf("could not delete reward key for smesherID %v: %v", entry.SmesherID.ShortString(), err)
+		}
+	}
+	if err := batch.Delete(getRewardLedgerKey(l)); err != nil {
+		return fmt.Errorf("could not delete reward ledger of layer %v: %v", l, err)
+	}
+	return nil
+}
+
+// getLatestLayerInState returns the encoded latest layer applied to the state, or database.ErrNotFound if no layer was
+// applied yet. It's written along with the rewards of the layer, but data dirs from before that have it in the general
+// database.
+func (m *DB) getLatestLayerInState() ([]byte, error) {
+	latest, err := m.transactions.Get(VERIFIED)
+	if err == database.ErrNotFound {
+		return m.general.Get(VERIFIED)
+	}
+	return latest, err
+}
+
+// writeLayerInState marks the layer as the latest layer applied to the state, and writes its rewards and reward ledger
+// in the same batch, so the rewards of a layer are written if and only if it was applied.
+func (m *DB) writeLayerInState(l types.LayerID, rewards *layerRewards) error {
+	emitted, burned := new(big.Int), new(big.Int)
+	if l > 0 {
+		var err error
+		if emitted, burned, err = m.getEmission(l - 1); err != nil {
+			return err
+		}
+	}
+	emitted.Add(emitted, rewards.layerReward)
+	burned.Add(burned, new(big.Int).SetUint64(rewards.burned()))
+	batch := m.transactions.NewBatch()
+	if err := putTransactionRewards(batch, l, rewards, emitted, burned); err != nil {
+		return err
+	}
+	if err := batch.Put(VERIFIED, l.Bytes()); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// rewindLayersInState marks the layer as the latest layer applied to the state, and deletes the rewards of the layers
+// after it, up to and including latest, in the same batch, since those layers will be applied again.
+func (m *DB) rewindLayersInState(l, latest types.LayerID) error {
+	batch := m.transactions.NewBatch()
+	for layer := l + 1; layer <= latest; layer++ {
+		if err := m.deleteTransactionRewards(batch, layer); err != nil {
+			return err
+		}
+	}
+	if err := batch.Put(VERIFIED, l.Bytes()); err != nil {
+		return err
+	}
 	return batch.Write()
 }
 
@@ -756,8 +1269,7 @@ func (m *DB) GetTransaction(id types.TransactionID) (*types.Transaction, error)
 	if err != nil {
 		return nil, fmt.Errorf("could not find transaction in database %v err=%v", hex.EncodeToString(id[:]), err)
 	}
-	var dbTx dbTransaction
-	err = types.BytesToInterface(tBytes, &dbTx)
+	dbTx, err := bytesToDbTransaction(tBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal transaction: %v", err)
 	}
@@ -869,6 +1381,28 @@ func (m *DB) Persist(key []byte, v interface{}) error {
 	return m.general.Put(key, buf)
 }
 
+// PersistBatch persists the items values using keys as their ids, in a single batch so either all of them are
+// written or none is. The keys whose value is nil are deleted.
+func (m *DB) PersistBatch(keys [][]byte, values []interface{}) error {
+	batch := m.general.NewBatch()
+	for i, key := range keys {
+		if values[i] == nil {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+		buf, err := types.InterfaceToBytes(values[i])
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(key, buf); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
 // Retrieve retrieves item by key into v
 func (m *DB) Retrieve(key []byte, v interface{}) (interface{}, error) {
 	val, err := m.general.Get(key)
@@ -3,6 +3,7 @@ package mesh
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"math/big"
 	"os"
@@ -26,16 +27,53 @@ const (
 	Path = "../tmp/mdb"
 )
 
+// testBackend is the backend of the mesh databases opened by the tests, they're kept in memory if it's empty
+var testBackend string
+
+// TestMain runs the tests with the mesh databases kept in memory, then again with each of the persistent backends.
+func TestMain(m *testing.M) {
+	code := m.Run()
+	for _, backend := range database.Backends {
+		if code != 0 {
+			break
+		}
+		testBackend = backend
+		code = m.Run()
+	}
+	os.Exit(code)
+}
+
+// newTestMeshDB opens a mesh database with the backend the tests run with. A persistent one is opened in a new
+// directory, which is removed when the test ends, and it's closed then if the test didn't close it.
+func newTestMeshDB(t testing.TB, lg log.Log) *DB {
+	if testBackend == "" {
+		return NewMemMeshDB(lg)
+	}
+	dir, err := ioutil.TempDir("", "mesh_test_")
+	require.NoError(t, err)
+	mdb, err := NewPersistentMeshDB(dir, testBackend, 5, lg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		select {
+		case <-mdb.exit:
+		default:
+			mdb.Close()
+		}
+		_ = os.RemoveAll(dir)
+	})
+	return mdb
+}
+
 func teardown() {
 	_ = os.RemoveAll(Path)
 }
 
-func getMeshDB() *DB {
-	return NewMemMeshDB(log.NewDefault("mdb"))
+func getMeshDB(t testing.TB) *DB {
+	return newTestMeshDB(t, log.NewDefault("mdb"))
 }
 
 func TestNewMeshDB(t *testing.T) {
-	mdb := getMeshDB()
+	mdb := getMeshDB(t)
 	bl := types.NewExistingBlock(1, []byte(rand.String(8)), nil)
 	err := mdb.AddBlock(bl)
 	assert.NoError(t, err)
@@ -46,7 +84,7 @@ func TestNewMeshDB(t *testing.T) {
 
 func TestMeshDB_AddBlock(t *testing.T) {
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	defer mdb.Close()
 	coinbase := types.HexToAddress("aaaa")
 
@@ -121,15 +159,15 @@ func createLayerWithRandVoting(index types.LayerID, prev []*types.Layer, blocksI
 }
 
 func TestForEachInView_Persistent(t *testing.T) {
-	mdb, err := NewPersistentMeshDB(Path+"/mesh_db/", 5, log.NewDefault("TestForEachInView"))
+	mdb, err := NewPersistentMeshDB(Path+"/mesh_db/", testBackend, 5, log.NewDefault("TestForEachInView"))
 	require.NoError(t, err)
-	defer mdb.Close()
 	defer teardown()
+	defer mdb.Close()
 	testForeachInView(mdb, t)
 }
 
 func TestForEachInView_InMem(t *testing.T) {
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	testForeachInView(mdb, t)
 }
 
@@ -171,7 +209,7 @@ func testForeachInView(mdb *DB, t *testing.T) {
 }
 
 func TestForEachInView_InMem_WithStop(t *testing.T) {
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	blocks := make(map[types.BlockID]*types.Block)
 	l := GenesisLayer()
 	gen := l.Blocks()[0]
@@ -204,7 +242,7 @@ func TestForEachInView_InMem_WithStop(t *testing.T) {
 }
 
 func TestForEachInView_InMem_WithLimitedLayer(t *testing.T) {
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	blocks := make(map[types.BlockID]*types.Block)
 	l := GenesisLayer()
 
@@ -240,7 +278,7 @@ func BenchmarkNewPersistentMeshDB(b *testing.B) {
 
 	r := require.New(b)
 
-	mdb, err := NewPersistentMeshDB(path.Join(Path, "mesh_db"), 5, log.NewDefault("meshDb"))
+	mdb, err := NewPersistentMeshDB(path.Join(Path, "mesh_db"), testBackend, 5, log.NewDefault("meshDb"))
 	require.NoError(b, err)
 	defer mdb.Close()
 	defer teardown()
@@ -317,7 +355,7 @@ func newSignerAndAddress(r *require.Assertions, seedStr string) (*signing.EdSign
 func TestMeshDB_GetStateProjection(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("DB.GetStateProjection"))
+	mdb := newTestMeshDB(t, log.NewDefault("DB.GetStateProjection"))
 	signer, origin := newSignerAndAddress(r, "123")
 	err := mdb.addToUnappliedTxs([]*types.Transaction{
 		newTx(r, signer, 0, 10),
@@ -334,7 +372,7 @@ func TestMeshDB_GetStateProjection(t *testing.T) {
 func TestMeshDB_GetStateProjection_WrongNonce(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer, origin := newSignerAndAddress(r, "123")
 	err := mdb.addToUnappliedTxs([]*types.Transaction{
 		newTx(r, signer, 1, 10),
@@ -351,7 +389,7 @@ func TestMeshDB_GetStateProjection_WrongNonce(t *testing.T) {
 func TestMeshDB_GetStateProjection_DetectNegativeBalance(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer, origin := newSignerAndAddress(r, "123")
 	err := mdb.addToUnappliedTxs([]*types.Transaction{
 		newTx(r, signer, 0, 10),
@@ -368,7 +406,7 @@ func TestMeshDB_GetStateProjection_DetectNegativeBalance(t *testing.T) {
 func TestMeshDB_GetStateProjection_NothingToApply(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 
 	nonce, balance, err := mdb.GetProjection(address(), initialNonce, initialBalance)
 	r.NoError(err)
@@ -379,7 +417,7 @@ func TestMeshDB_GetStateProjection_NothingToApply(t *testing.T) {
 func TestMeshDB_UnappliedTxs(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 
 	signer1, origin1 := newSignerAndAddress(r, "thc")
 	signer2, origin2 := newSignerAndAddress(r, "cbd")
@@ -425,7 +463,7 @@ func TestMeshDB_UnappliedTxs(t *testing.T) {
 func TestMeshDB_testGetTransactions(t *testing.T) {
 	r := require.New(t)
 
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 
 	signer1, addr1 := newSignerAndAddress(r, "thc")
 	signer2, _ := newSignerAndAddress(r, "cbd")
@@ -481,7 +519,7 @@ func getTxns(r *require.Assertions, mdb *DB, origin types.Address) []TinyTx {
 
 func TestMeshDB_testGetRewards(t *testing.T) {
 	r := require.New(t)
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer1, addr1 := newSignerAndAddress(r, "123")
 	signer2, addr2 := newSignerAndAddress(r, "456")
 	signer3, addr3 := newSignerAndAddress(r, "789")
@@ -531,13 +569,13 @@ func TestMeshDB_testGetRewards(t *testing.T) {
 		},
 	}
 
-	err := mdb.writeTransactionRewards(1, test1Map, big.NewInt(10000), big.NewInt(9000))
+	err := writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: test1Map, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(2, test2Map, big.NewInt(20000), big.NewInt(19000))
+	err = writeTransactionRewards(mdb, 2, &layerRewards{coinbasesAndSmeshers: test2Map, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(3, test3Map, big.NewInt(15000), big.NewInt(14500))
+	err = writeTransactionRewards(mdb, 3, &layerRewards{coinbasesAndSmeshers: test3Map, blockTotalReward: big.NewInt(15000), blockLayerReward: big.NewInt(14500)})
 	r.NoError(err)
 
 	rewards, err := mdb.GetRewards(addr2)
@@ -562,7 +600,7 @@ func TestMeshDB_testGetRewards(t *testing.T) {
 
 func TestMeshDB_testGetRewardsBySmesher(t *testing.T) {
 	r := require.New(t)
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer1, addr1 := newSignerAndAddress(r, "123")
 	signer2, addr2 := newSignerAndAddress(r, "456")
 	signer3, addr3 := newSignerAndAddress(r, "789")
@@ -616,13 +654,13 @@ func TestMeshDB_testGetRewardsBySmesher(t *testing.T) {
 		},
 	}
 
-	err := mdb.writeTransactionRewards(1, test1Map, big.NewInt(10000), big.NewInt(9000))
+	err := writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: test1Map, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(2, test2Map, big.NewInt(20000), big.NewInt(19000))
+	err = writeTransactionRewards(mdb, 2, &layerRewards{coinbasesAndSmeshers: test2Map, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(3, test3Map, big.NewInt(15000), big.NewInt(14500))
+	err = writeTransactionRewards(mdb, 3, &layerRewards{coinbasesAndSmeshers: test3Map, blockTotalReward: big.NewInt(15000), blockLayerReward: big.NewInt(14500)})
 	r.NoError(err)
 
 	rewards, err := mdb.GetRewardsBySmesherID(smesher2)
@@ -647,7 +685,7 @@ func TestMeshDB_testGetRewardsBySmesher(t *testing.T) {
 
 func TestMeshDB_testGetRewardsBySmesherChangingLayer(t *testing.T) {
 	r := require.New(t)
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer1, addr1 := newSignerAndAddress(r, "123")
 	signer2, addr2 := newSignerAndAddress(r, "456")
 	signer3, addr3 := newSignerAndAddress(r, "789")
@@ -701,13 +739,13 @@ func TestMeshDB_testGetRewardsBySmesherChangingLayer(t *testing.T) {
 		},
 	}
 
-	err := mdb.writeTransactionRewards(1, test1Map, big.NewInt(10000), big.NewInt(9000))
+	err := writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: test1Map, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(2, test2Map, big.NewInt(20000), big.NewInt(19000))
+	err = writeTransactionRewards(mdb, 2, &layerRewards{coinbasesAndSmeshers: test2Map, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(3, test3Map, big.NewInt(15000), big.NewInt(14500))
+	err = writeTransactionRewards(mdb, 3, &layerRewards{coinbasesAndSmeshers: test3Map, blockTotalReward: big.NewInt(15000), blockLayerReward: big.NewInt(14500)})
 	r.NoError(err)
 
 	rewards, err := mdb.GetRewardsBySmesherID(smesher2)
@@ -738,7 +776,7 @@ func TestMeshDB_testGetRewardsBySmesherChangingLayer(t *testing.T) {
 
 func TestMeshDB_testGetRewardsBySmesherMultipleSmeshers(t *testing.T) {
 	r := require.New(t)
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer1, addr1 := newSignerAndAddress(r, "123")
 	signer2, addr2 := newSignerAndAddress(r, "456")
 	signer3, addr3 := newSignerAndAddress(r, "789")
@@ -779,7 +817,7 @@ func TestMeshDB_testGetRewardsBySmesherMultipleSmeshers(t *testing.T) {
 		},
 	}
 
-	err := mdb.writeTransactionRewards(1, test1Map, big.NewInt(10000), big.NewInt(9000))
+	err := writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: test1Map, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)})
 	r.NoError(err)
 
 	rewards, err := mdb.GetRewardsBySmesherID(smesher2)
@@ -816,7 +854,7 @@ func TestMeshDB_testGetRewardsBySmesherMultipleSmeshers(t *testing.T) {
 
 func TestMeshDB_testGetRewardsBySmesherMultipleSmeshersAndLayers(t *testing.T) {
 	r := require.New(t)
-	mdb := NewMemMeshDB(log.NewDefault("TestForEachInView"))
+	mdb := newTestMeshDB(t, log.NewDefault("TestForEachInView"))
 	signer1, addr1 := newSignerAndAddress(r, "123")
 	signer2, addr2 := newSignerAndAddress(r, "456")
 	signer3, addr3 := newSignerAndAddress(r, "789")
@@ -870,10 +908,10 @@ func TestMeshDB_testGetRewardsBySmesherMultipleSmeshersAndLayers(t *testing.T) {
 		},
 	}
 
-	err := mdb.writeTransactionRewards(1, test1Map, big.NewInt(10000), big.NewInt(9000))
+	err := writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: test1Map, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)})
 	r.NoError(err)
 
-	err = mdb.writeTransactionRewards(2, test2Map, big.NewInt(20000), big.NewInt(19000))
+	err = writeTransactionRewards(mdb, 2, &layerRewards{coinbasesAndSmeshers: test2Map, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000)})
 	r.NoError(err)
 
 	rewards, err := mdb.GetRewardsBySmesherID(smesher2)
@@ -913,3 +951,272 @@ func TestMeshDB_testGetRewardsBySmesherMultipleSmeshersAndLayers(t *testing.T) {
 		{Layer: 2, TotalReward: 20000, LayerRewardEstimate: 19000, SmesherID: smesher4, Coinbase: addr1},
 	}, rewards)
 }
+
+// writeTransactionRewards writes the rewards of a layer without marking it as applied to the state. The layer reward
+// defaults to zero, since it only counts towards the emission.
+func writeTransactionRewards(mdb *DB, l types.LayerID, rewards *layerRewards) error {
+	if rewards.layerReward == nil {
+		rewards.layerReward = new(big.Int)
+	}
+	batch := mdb.transactions.NewBatch()
+	if err := putTransactionRewards(batch, l, rewards, new(big.Int), new(big.Int)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func TestMeshDB_writeLayerInState(t *testing.T) {
+	r := require.New(t)
+	mdb := NewMemMeshDB(log.NewDefault("TestWriteLayerInState"))
+	signer1, addr1 := newSignerAndAddress(r, "123")
+	signer2, addr2 := newSignerAndAddress(r, "456")
+
+	smesher1 := types.NodeID{
+		Key:          signer1.PublicKey().String(),
+		VRFPublicKey: signer1.PublicKey().Bytes(),
+	}
+	smesher2 := types.NodeID{
+		Key:          signer2.PublicKey().String(),
+		VRFPublicKey: signer2.PublicKey().Bytes(),
+	}
+
+	testMap := map[types.Address]map[string]uint64{
+		addr1: {
+			smesher1.String(): 1,
+		},
+		addr2: {
+			smesher2.String(): 1,
+		},
+	}
+
+	_, err := mdb.getLatestLayerInState()
+	r.Equal(database.ErrNotFound, err)
+	// data dirs from before the latest layer in state was written with its rewards have it in the general database
+	r.NoError(mdb.general.Put(VERIFIED, types.LayerID(1).Bytes()))
+	latest, err := mdb.getLatestLayerInState()
+	r.NoError(err)
+	r.Equal(types.LayerID(1).Bytes(), latest)
+
+	r.NoError(mdb.writeLayerInState(2, &layerRewards{coinbasesAndSmeshers: testMap, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000), layerReward: big.NewInt(19000)}))
+	// a layer without rewards is still recorded
+	r.NoError(mdb.writeLayerInState(3, &layerRewards{blockTotalReward: big.NewInt(0), blockLayerReward: big.NewInt(0), layerReward: big.NewInt(0)}))
+	latest, err = mdb.getLatestLayerInState()
+	r.NoError(err)
+	r.Equal(types.LayerID(3).Bytes(), latest)
+
+	for _, l := range []types.LayerID{2, 3} {
+		found, err := mdb.hasTransactionRewards(l)
+		r.NoError(err)
+		r.True(found, "layer %v", l)
+	}
+	found, err := mdb.hasTransactionRewards(4)
+	r.NoError(err)
+	r.False(found)
+
+	rewards, err := mdb.GetRewards(addr1)
+	r.NoError(err)
+	r.Equal([]types.Reward{
+		{Layer: 2, TotalReward: 20000, LayerRewardEstimate: 19000, SmesherID: smesher1, Coinbase: addr1},
+	}, rewards)
+
+	rewards, err = mdb.GetRewardsBySmesherID(smesher2)
+	r.NoError(err)
+	r.Equal([]types.Reward{
+		{Layer: 2, TotalReward: 20000, LayerRewardEstimate: 19000, SmesherID: smesher2, Coinbase: addr2},
+	}, rewards)
+}
+
+func TestMeshDB_pruneLayers(t *testing.T) {
+	r := require.New(t)
+	teardown()
+	defer teardown()
+	mdb, err := NewPersistentMeshDB(Path+"/mesh_db/", testBackend, 5, log.NewDefault("TestPruneLayers"))
+	r.NoError(err)
+
+	signer, _ := newSignerAndAddress(r, "thc")
+	applied := make(map[types.TransactionID]*types.LayerID)
+	var blocks []*types.Block
+	var txs []*types.Transaction
+	for l := types.LayerID(1); l <= 3; l++ {
+		tx := newTx(r, signer, uint64(l), 100)
+		r.NoError(mdb.writeTransactions(l, []*types.Transaction{tx}))
+		block := types.NewExistingBlock(l, []byte(rand.String(8)), []types.TransactionID{tx.ID()})
+		r.NoError(mdb.AddBlock(block))
+		blocks = append(blocks, block)
+		txs = append(txs, tx)
+	}
+	// the transaction of layer 2 was only applied in layer 3, after the pruned layers
+	layer1, layer3 := types.LayerID(1), types.LayerID(3)
+	applied[txs[0].ID()] = &layer1
+	applied[txs[1].ID()] = &layer3
+	txApplied := func(id types.TransactionID) *types.LayerID { return applied[id] }
+
+	r.Equal(types.LayerID(0), mdb.PrunedLayer())
+	r.NoError(mdb.pruneLayers(2, txApplied))
+	r.Equal(types.LayerID(2), mdb.PrunedLayer())
+
+	for _, l := range []types.LayerID{1, 2} {
+		_, err := mdb.LayerBlocks(l)
+		r.Equal(ErrLayerPruned, err)
+		// the block IDs of pruned layers are kept
+		ids, err := mdb.LayerBlockIds(l)
+		r.NoError(err)
+		r.Equal([]types.BlockID{blocks[l-1].ID()}, ids)
+		_, err = mdb.GetBlock(blocks[l-1].ID())
+		r.Equal(database.ErrNotFound, err)
+	}
+	_, err = mdb.GetTransaction(txs[0].ID())
+	r.Error(err)
+	r.Empty(mdb.GetTransactionsByOrigin(1, txs[0].Origin()))
+	_, err = mdb.GetTransaction(txs[1].ID())
+	r.NoError(err)
+
+	layerBlocks, err := mdb.LayerBlocks(3)
+	r.NoError(err)
+	r.Equal([]*types.Block{blocks[2]}, layerBlocks)
+	_, err = mdb.GetTransaction(txs[2].ID())
+	r.NoError(err)
+
+	// pruning layers that were already pruned is a no-op
+	r.NoError(mdb.pruneLayers(1, txApplied))
+	r.Equal(types.LayerID(2), mdb.PrunedLayer())
+
+	// the latest pruned layer is persisted
+	mdb.Close()
+	mdb, err = NewPersistentMeshDB(Path+"/mesh_db/", testBackend, 5, log.NewDefault("TestPruneLayers"))
+	r.NoError(err)
+	defer mdb.Close()
+	r.Equal(types.LayerID(2), mdb.PrunedLayer())
+}
+
+func TestMeshDB_rewindLayersInState(t *testing.T) {
+	r := require.New(t)
+	mdb := newTestMeshDB(t, log.NewDefault("TestRewindLayersInState"))
+	signer1, addr1 := newSignerAndAddress(r, "123")
+	signer2, addr2 := newSignerAndAddress(r, "456")
+
+	smesher1 := types.NodeID{
+		Key:          signer1.PublicKey().String(),
+		VRFPublicKey: signer1.PublicKey().Bytes(),
+	}
+	smesher2 := types.NodeID{
+		Key:          signer2.PublicKey().String(),
+		VRFPublicKey: signer2.PublicKey().Bytes(),
+	}
+
+	testMap := map[types.Address]map[string]uint64{
+		addr1: {
+			smesher1.String(): 1,
+		},
+		addr2: {
+			smesher2.String(): 1,
+		},
+	}
+
+	r.NoError(writeTransactionRewards(mdb, 1, &layerRewards{coinbasesAndSmeshers: testMap, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000)}))
+	r.NoError(writeTransactionRewards(mdb, 2, &layerRewards{coinbasesAndSmeshers: testMap, blockTotalReward: big.NewInt(20000), blockLayerReward: big.NewInt(19000)}))
+	// a layer without rewards is still recorded
+	r.NoError(writeTransactionRewards(mdb, 3, &layerRewards{blockTotalReward: big.NewInt(0), blockLayerReward: big.NewInt(0)}))
+
+	for _, l := range []types.LayerID{1, 2, 3} {
+		found, err := mdb.hasTransactionRewards(l)
+		r.NoError(err)
+		r.True(found, "layer %v", l)
+	}
+	found, err := mdb.hasTransactionRewards(4)
+	r.NoError(err)
+	r.False(found)
+
+	// the rewards of layer 4 weren't written, which is fine
+	r.NoError(mdb.rewindLayersInState(1, 4))
+	latest, err := mdb.getLatestLayerInState()
+	r.NoError(err)
+	r.Equal(types.LayerID(1).Bytes(), latest)
+
+	for _, l := range []types.LayerID{2, 3} {
+		found, err := mdb.hasTransactionRewards(l)
+		r.NoError(err)
+		r.False(found, "layer %v", l)
+	}
+
+	rewards, err := mdb.GetRewards(addr1)
+	r.NoError(err)
+	r.Equal([]types.Reward{
+		{Layer: 1, TotalReward: 10000, LayerRewardEstimate: 9000, SmesherID: smesher1, Coinbase: addr1},
+	}, rewards)
+
+	rewards, err = mdb.GetRewardsBySmesherID(smesher2)
+	r.NoError(err)
+	r.Equal([]types.Reward{
+		{Layer: 1, TotalReward: 10000, LayerRewardEstimate: 9000, SmesherID: smesher2, Coinbase: addr2},
+	}, rewards)
+}
+
+func TestMeshDB_ExplorerIndex(t *testing.T) {
+	r := require.New(t)
+	mdb := newTestMeshDB(t, log.NewDefault("TestExplorerIndex"))
+	defer mdb.Close()
+
+	txSigner, _ := newSignerAndAddress(r, "thc")
+	tx1, tx2 := newTx(r, txSigner, 0, 100).ID(), newTx(r, txSigner, 1, 100).ID()
+	unindexed := types.NewExistingBlock(1, []byte(rand.String(8)), []types.TransactionID{tx1})
+	r.NoError(mdb.AddBlock(unindexed))
+	_, err := mdb.GetTransactionBlocks(tx1)
+	r.Equal(ErrIndexDisabled, err)
+	_, err = mdb.GetSmesherBlocks(unindexed.MinerID())
+	r.Equal(ErrIndexDisabled, err)
+
+	mdb.ExplorerIndex = true
+	signer := signing.NewEdSigner()
+	var blocks []*types.Block
+	for l := types.LayerID(2); l <= 3; l++ {
+		block := types.NewExistingBlock(l, []byte(rand.String(8)), []types.TransactionID{tx1, tx2})
+		block.Signature = signer.Sign(block.Bytes())
+		block.Initialize()
+		r.NoError(mdb.AddBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	// only blocks written with the index enabled are indexed
+	ids, err := mdb.GetTransactionBlocks(tx1)
+	r.NoError(err)
+	r.ElementsMatch([]types.BlockID{blocks[0].ID(), blocks[1].ID()}, ids)
+	ids, err = mdb.GetSmesherBlocks(signer.PublicKey())
+	r.NoError(err)
+	r.Equal([]types.BlockID{blocks[0].ID(), blocks[1].ID()}, ids)
+	ids, err = mdb.GetSmesherBlocks(unindexed.MinerID())
+	r.NoError(err)
+	r.Empty(ids)
+
+	// the blocks written before the index was enabled are indexed by the backfill, which only runs once
+	r.NoError(mdb.BuildExplorerIndex())
+	ids, err = mdb.GetSmesherBlocks(unindexed.MinerID())
+	r.NoError(err)
+	r.Equal([]types.BlockID{unindexed.ID()}, ids)
+	ids, err = mdb.GetTransactionBlocks(tx1)
+	r.NoError(err)
+	r.ElementsMatch([]types.BlockID{unindexed.ID(), blocks[0].ID(), blocks[1].ID()}, ids)
+	built, err := mdb.general.Has(explorerIndexKey)
+	r.NoError(err)
+	r.True(built)
+
+	// disabling the index marks it as incomplete, so it's built again once it's enabled
+	mdb.ExplorerIndex = false
+	r.NoError(mdb.BuildExplorerIndex())
+	built, err = mdb.general.Has(explorerIndexKey)
+	r.NoError(err)
+	r.False(built)
+	mdb.ExplorerIndex = true
+
+	// the index entries of pruned blocks are deleted
+	r.NoError(mdb.pruneLayers(2, func(types.TransactionID) *types.LayerID { return nil }))
+	ids, err = mdb.GetTransactionBlocks(tx1)
+	r.NoError(err)
+	r.Equal([]types.BlockID{blocks[1].ID()}, ids)
+	ids, err = mdb.GetTransactionBlocks(tx2)
+	r.NoError(err)
+	r.Equal([]types.BlockID{blocks[1].ID()}, ids)
+	ids, err = mdb.GetSmesherBlocks(signer.PublicKey())
+	r.NoError(err)
+	r.Equal([]types.BlockID{blocks[1].ID()}, ids)
+}
@@ -1,26 +1,216 @@
 package mesh
 
 import (
+	"fmt"
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"math"
 	"math/big"
+	"sync"
+)
+
+// Supported reward remainder policies, used as values for Config.RemainderPolicy. The remainder is the part of a
+// layer's total reward that can't be split evenly among its rewarded blocks.
+const (
+	// RemainderBurn doesn't credit the remainder to any account, taking it out of circulation. The burned amount is
+	// recorded in the reward ledger.
+	RemainderBurn = "burn"
+	// RemainderRoundRobin gives one unit of the remainder to each of the next blocks in order of block ID, starting from
+	// the block at the position of the layer number modulo the number of blocks, so it rotates between layers
+	RemainderRoundRobin = "round-robin"
 )
 
 // Config defines the configuration options for Spacemesh rewards.
 type Config struct {
 	BaseReward *big.Int `mapstructure:"base-reward"`
+	// EpochBudget, if set, is the total reward for the layers of each epoch, split evenly among them, instead of
+	// BaseReward for each layer. The decay and halvings apply to it the same way.
+	EpochBudget *big.Int `mapstructure:"epoch-budget"`
+	// EpochDecay is the fraction, in parts per million, by which the layer reward decreases each epoch.
+	EpochDecay uint64 `mapstructure:"epoch-decay"`
+	// HalvingInterval is the number of epochs after which the layer reward halves, or 0 for no halvings.
+	HalvingInterval uint64 `mapstructure:"halving-interval"`
+	// SupplyCap, if set, is the total reward for all layers. The reward of the layer that reaches it is cut so the
+	// emission doesn't exceed it, and later layers aren't rewarded.
+	SupplyCap *big.Int `mapstructure:"supply-cap"`
+	// RemainderPolicy is how the remainder of each layer's reward is distributed: RemainderBurn (the default, also used
+	// if it's empty) or RemainderRoundRobin.
+	RemainderPolicy string `mapstructure:"reward-remainder-policy"`
 }
 
 // DefaultMeshConfig returns the default Config.
 func DefaultMeshConfig() Config {
 	return Config{
-		BaseReward: big.NewInt(50 * int64(math.Pow10(12))),
+		BaseReward:      big.NewInt(50 * int64(math.Pow10(12))),
+		RemainderPolicy: RemainderBurn,
+	}
+}
+
+// Validate returns an error if the config has an unknown remainder policy.
+func (c Config) Validate() error {
+	switch c.RemainderPolicy {
+	case "", RemainderBurn, RemainderRoundRobin:
+		return nil
+	default:
+		return fmt.Errorf("unknown reward remainder policy %q", c.RemainderPolicy)
+	}
+}
+
+func (c Config) remainderPolicy() string {
+	if c.RemainderPolicy == "" {
+		return RemainderBurn
+	}
+	return c.RemainderPolicy
+}
+
+// decayDenominator is the denominator of Config.EpochDecay
+const decayDenominator = 1000000
+
+// emissionSchedule computes the emission schedule incrementally: it keeps the running totals at the start of the latest
+// epoch it was asked about, so that each layer only walks the epochs since. It starts over from epoch 0 for an earlier
+// epoch, without moving the running totals back, or when the reward config changes.
+type emissionSchedule struct {
+	mu      sync.Mutex
+	params  Config
+	epoch   types.EpochID // the epoch the running totals are at the start of
+	amount  *big.Int      // the amount the schedule starts the epoch with, before the epoch's halvings
+	emitted *big.Int      // the scheduled total reward for all the epochs before it
+}
+
+// calculateSupply returns the emission schedule as of the given layer, walking it from layer 0.
+func calculateSupply(layer types.LayerID, params Config) *types.Supply {
+	return new(emissionSchedule).supply(layer, params)
+}
+
+// supply returns the emission schedule as of the given layer. Each epoch, the amount the schedule starts with
+// (BaseReward or EpochBudget) is decreased by the decay, rounding down, and the epoch's layer rewards are derived from it
+// after applying the halvings. The supply cap is applied last, so it doesn't change the curve, only cuts it off.
+func (s *emissionSchedule) supply(layer types.LayerID, params Config) *types.Supply {
+	epoch := layer.GetEpoch()
+	layersPerEpoch := uint64((epoch + 1).FirstLayer() - epoch.FirstLayer())
+
+	s.mu.Lock()
+	if s.amount == nil || !sameSchedule(s.params, params) {
+		s.params = params
+		s.epoch, s.amount, s.emitted = 0, initialAmount(params), new(big.Int)
+	}
+	start, amount, emitted := s.epoch, new(big.Int).Set(s.amount), new(big.Int).Set(s.emitted)
+	if epoch < start {
+		start, amount, emitted = 0, initialAmount(params), new(big.Int)
+	}
+	for e := start; e < epoch && amount.Sign() > 0; e++ {
+		scheduled := halve(amount, e, params)
+		if scheduled.Sign() == 0 {
+			// halvings only decrease the amount, so no later epoch is rewarded either
+			amount.SetInt64(0)
+			break
+		}
+		emitted.Add(emitted, capReward(epochReward(scheduled, layersPerEpoch, params), emitted, params))
+		if params.SupplyCap != nil && emitted.Cmp(params.SupplyCap) >= 0 {
+			// the later epochs aren't rewarded either, whatever the amount
+			break
+		}
+		decay(amount, params)
+	}
+	if epoch > s.epoch {
+		s.epoch, s.amount, s.emitted = epoch, new(big.Int).Set(amount), new(big.Int).Set(emitted)
+	}
+	s.mu.Unlock()
+
+	scheduled := halve(amount, epoch, params)
+	supply := &types.Supply{
+		Layer:       layer,
+		EpochReward: capReward(epochReward(scheduled, layersPerEpoch, params), emitted, params),
+	}
+	for l := epoch.FirstLayer(); l <= layer; l++ {
+		supply.LayerReward = capReward(layerReward(scheduled, uint64(l-epoch.FirstLayer()), layersPerEpoch, params), emitted, params)
+		emitted.Add(emitted, supply.LayerReward)
+	}
+	supply.Scheduled = emitted
+	if params.SupplyCap != nil {
+		supply.Cap = new(big.Int).Set(params.SupplyCap)
 	}
+	return supply
 }
 
-func calculateLayerReward(id types.LayerID, params Config) *big.Int {
-	// todo: add inflation rules here
-	return params.BaseReward
+// initialAmount returns the amount the schedule starts epoch 0 with.
+func initialAmount(params Config) *big.Int {
+	if params.EpochBudget != nil {
+		return new(big.Int).Set(params.EpochBudget)
+	}
+	if params.BaseReward != nil {
+		return new(big.Int).Set(params.BaseReward)
+	}
+	return new(big.Int)
+}
+
+// sameSchedule returns true if the configs have the same emission schedule.
+func sameSchedule(a, b Config) bool {
+	same := func(x, y *big.Int) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return x.Cmp(y) == 0
+	}
+	return same(a.BaseReward, b.BaseReward) && same(a.EpochBudget, b.EpochBudget) && same(a.SupplyCap, b.SupplyCap) &&
+		a.EpochDecay == b.EpochDecay && a.HalvingInterval == b.HalvingInterval
+}
+
+// halve returns the amount after applying the halvings that happened by the epoch.
+func halve(amount *big.Int, epoch types.EpochID, params Config) *big.Int {
+	if params.HalvingInterval == 0 {
+		return new(big.Int).Set(amount)
+	}
+	halvings := uint64(epoch) / params.HalvingInterval
+	if halvings > uint64(amount.BitLen()) {
+		return new(big.Int)
+	}
+	return new(big.Int).Rsh(amount, uint(halvings))
+}
+
+// decay decreases amount by the epoch decay, rounding down.
+func decay(amount *big.Int, params Config) {
+	if params.EpochDecay >= decayDenominator {
+		amount.SetInt64(0)
+		return
+	}
+	amount.Mul(amount, new(big.Int).SetUint64(decayDenominator-params.EpochDecay))
+	amount.Quo(amount, big.NewInt(decayDenominator))
+}
+
+// epochReward returns the total reward for the layers of an epoch with the scheduled amount, before the supply cap.
+func epochReward(scheduled *big.Int, layersPerEpoch uint64, params Config) *big.Int {
+	if params.EpochBudget != nil {
+		return scheduled
+	}
+	return new(big.Int).Mul(scheduled, new(big.Int).SetUint64(layersPerEpoch))
+}
+
+// layerReward returns the reward for the layer at the given position in an epoch with the scheduled amount, before the
+// supply cap. An epoch budget that doesn't divide evenly among the layers gives the remainder to the first layers.
+func layerReward(scheduled *big.Int, position, layersPerEpoch uint64, params Config) *big.Int {
+	if params.EpochBudget == nil {
+		return new(big.Int).Set(scheduled)
+	}
+	share, remainder := new(big.Int).QuoRem(scheduled, new(big.Int).SetUint64(layersPerEpoch), new(big.Int))
+	if remainder.Cmp(new(big.Int).SetUint64(position)) > 0 {
+		share.Add(share, big.NewInt(1))
+	}
+	return share
+}
+
+// capReward returns the part of the reward that can be emitted after the given emission without exceeding the supply cap.
+func capReward(reward, emitted *big.Int, params Config) *big.Int {
+	if params.SupplyCap == nil {
+		return reward
+	}
+	left := new(big.Int).Sub(params.SupplyCap, emitted)
+	if left.Sign() <= 0 {
+		return new(big.Int)
+	}
+	if reward.Cmp(left) > 0 {
+		return left
+	}
+	return reward
 }
 
 func calculateActualRewards(layer types.LayerID, rewards *big.Int, numBlocks *big.Int) (*big.Int, *big.Int) {
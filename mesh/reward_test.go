@@ -1,11 +1,14 @@
 package mesh
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/log"
@@ -20,6 +23,9 @@ type MockMapState struct {
 	Txs         []*types.Transaction
 	Pool        []*types.Transaction
 	TotalReward int64
+	Persisted   types.LayerID // the latest layer whose state can be loaded
+	ApplyErr    error         // returned by ApplyTransactions when set
+	Reverted    []types.TransactionID
 }
 
 func (s *MockMapState) GetAllAccounts() (*types.MultipleAccountsState, error) {
@@ -31,24 +37,49 @@ func (s *MockMapState) ValidateAndAddTxToPool(tx *types.Transaction) error {
 	return nil
 }
 
-func (s MockMapState) LoadState(types.LayerID) error                       { panic("implement me") }
-func (MockMapState) GetStateRoot() types.Hash32                            { return [32]byte{} }
-func (MockMapState) ValidateNonceAndBalance(*types.Transaction) error      { panic("implement me") }
-func (MockMapState) GetLayerApplied(types.TransactionID) *types.LayerID    { panic("implement me") }
-func (MockMapState) GetLayerStateRoot(types.LayerID) (types.Hash32, error) { panic("implement me") }
-func (MockMapState) GetBalance(types.Address) uint64                       { panic("implement me") }
-func (MockMapState) GetNonce(types.Address) uint64                         { panic("implement me") }
+func (s MockMapState) LoadState(layer types.LayerID) error {
+	if layer > s.Persisted {
+		return errors.New("missing trie node")
+	}
+	return nil
+}
+
+func (s *MockMapState) RevertLayers(_, _ types.LayerID, txIDs []types.TransactionID) error {
+	s.Reverted = append(s.Reverted, txIDs...)
+	return nil
+}
+
+func (MockMapState) GetStateRoot() types.Hash32                             { return [32]byte{} }
+func (MockMapState) ValidateNonceAndBalance(*types.Transaction) error       { panic("implement me") }
+func (MockMapState) GetLayerApplied(types.TransactionID) *types.LayerID     { panic("implement me") }
+func (MockMapState) GetReceipt(types.TransactionID) (*types.Receipt, error) { panic("implement me") }
+func (MockMapState) GetAccountControl(types.Address) *types.AccountControl  { panic("implement me") }
+func (MockMapState) GetLayerStateRoot(types.LayerID) (types.Hash32, error)  { panic("implement me") }
+func (MockMapState) GetBalance(types.Address) uint64                        { panic("implement me") }
+func (MockMapState) GetNonce(types.Address) uint64                          { panic("implement me") }
+
+func (MockMapState) GetAccountAtLayer(types.Address, types.LayerID) (*types.AccountState, error) {
+	panic("implement me")
+}
+
+func (MockMapState) GetAccountProof(types.Address, types.LayerID) (*types.AccountProof, error) {
+	panic("implement me")
+}
 
 func (s *MockMapState) ApplyTransactions(_ types.LayerID, txs []*types.Transaction) (int, error) {
+	if s.ApplyErr != nil {
+		return 0, s.ApplyErr
+	}
 	s.Txs = append(s.Txs, txs...)
 	return 0, nil
 }
 
-func (s *MockMapState) ApplyRewards(_ types.LayerID, miners []types.Address, reward *big.Int) {
+func (s *MockMapState) ApplyRewards(_ types.LayerID, miners []types.Address, reward *big.Int) error {
 	for _, minerID := range miners {
 		s.Rewards[minerID] = reward
 		s.TotalReward += reward.Int64()
 	}
+	return nil
 }
 
 func (s *MockMapState) AddressExists(types.Address) bool {
@@ -61,10 +92,11 @@ func ConfigTst() Config {
 	}
 }
 
-func getMeshWithMapState(id string, s txProcessor) (*Mesh, *AtxDbMock) {
+func getMeshWithMapState(t testing.TB, id string, s txProcessor) (*Mesh, *AtxDbMock) {
 	atxDb := NewAtxDbMock()
 	lg := log.NewDefault(id)
-	mshDb := NewMemMeshDB(lg)
+	mshDb := newTestMeshDB(t, lg)
+	mshDb.contextualValidity.Close()
 	mshDb.contextualValidity = &ContextualValidityMock{}
 	return NewMesh(mshDb, atxDb, ConfigTst(), &MeshValidatorMock{}, newMockTxMemPool(), s, lg), atxDb
 }
@@ -91,14 +123,14 @@ func init() {
 
 func TestMesh_AccumulateRewards_happyFlow(t *testing.T) {
 	s := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	layers, atxDB := getMeshWithMapState("t1", s)
+	layers, atxDB := getMeshWithMapState(t, "t1", s)
 	defer layers.Close()
 
 	var totalFee int64
 	block1 := types.NewExistingBlock(1, []byte(rand.String(8)), nil)
 
 	coinbase1 := types.HexToAddress("0xaaa")
-	atx := newActivationTx(types.NodeID{Key: "1", VRFPublicKey: []byte("bbbbb")}, 0, *types.EmptyATXID, 1, 0, goldenATXID, coinbase1, 10, []types.BlockID{}, &types.NIPST{})
+	atx := newActivationTx(types.NodeID{Key: nodeKey(1), VRFPublicKey: []byte("bbbbb")}, 0, *types.EmptyATXID, 1, 0, goldenATXID, coinbase1, 10, []types.BlockID{}, &types.NIPST{})
 	atxDB.AddAtx(atx.ID(), atx)
 	block1.ATXID = atx.ID()
 	totalFee += addTransactionsWithFee(t, layers.DB, block1, 15, 7)
@@ -106,7 +138,7 @@ func TestMesh_AccumulateRewards_happyFlow(t *testing.T) {
 	block2 := types.NewExistingBlock(1, []byte(rand.String(8)), nil)
 
 	coinbase2 := types.HexToAddress("0xbbb")
-	atx = newActivationTx(types.NodeID{Key: "2", VRFPublicKey: []byte("bbbbb")}, 0, *types.EmptyATXID, 1, 0, goldenATXID, coinbase2, 10, []types.BlockID{}, &types.NIPST{})
+	atx = newActivationTx(types.NodeID{Key: nodeKey(2), VRFPublicKey: []byte("bbbbb")}, 0, *types.EmptyATXID, 1, 0, goldenATXID, coinbase2, 10, []types.BlockID{}, &types.NIPST{})
 	atxDB.AddAtx(atx.ID(), atx)
 	block2.ATXID = atx.ID()
 	totalFee += addTransactionsWithFee(t, layers.DB, block2, 13, rand.Int63n(100))
@@ -114,7 +146,7 @@ func TestMesh_AccumulateRewards_happyFlow(t *testing.T) {
 	block3 := types.NewExistingBlock(1, []byte(rand.String(8)), nil)
 
 	coinbase3 := types.HexToAddress("0xccc")
-	atx = newActivationTx(types.NodeID{Key: "3", VRFPublicKey: []byte("bbbbb")}, 0, goldenATXID, 1, 0, goldenATXID, coinbase3, 10, []types.BlockID{}, &types.NIPST{})
+	atx = newActivationTx(types.NodeID{Key: nodeKey(3), VRFPublicKey: []byte("bbbbb")}, 0, goldenATXID, 1, 0, goldenATXID, coinbase3, 10, []types.BlockID{}, &types.NIPST{})
 	atxDB.AddAtx(atx.ID(), atx)
 	block3.ATXID = atx.ID()
 	totalFee += addTransactionsWithFee(t, layers.DB, block3, 17, rand.Int63n(100))
@@ -122,7 +154,7 @@ func TestMesh_AccumulateRewards_happyFlow(t *testing.T) {
 	block4 := types.NewExistingBlock(1, []byte(rand.String(8)), nil)
 
 	coinbase4 := types.HexToAddress("0xddd")
-	atx = newActivationTx(types.NodeID{Key: "4", VRFPublicKey: []byte("bbbbb")}, 0, goldenATXID, 1, 0, goldenATXID, coinbase4, 10, []types.BlockID{}, &types.NIPST{})
+	atx = newActivationTx(types.NodeID{Key: nodeKey(4), VRFPublicKey: []byte("bbbbb")}, 0, goldenATXID, 1, 0, goldenATXID, coinbase4, 10, []types.BlockID{}, &types.NIPST{})
 	atxDB.AddAtx(atx.ID(), atx)
 	block4.ATXID = atx.ID()
 	totalFee += addTransactionsWithFee(t, layers.DB, block4, 16, rand.Int63n(100))
@@ -137,11 +169,14 @@ func TestMesh_AccumulateRewards_happyFlow(t *testing.T) {
 
 	l, err := layers.GetLayer(1)
 	assert.NoError(t, err)
-	layers.accumulateRewards(l, params)
+	_, err = layers.accumulateRewards(l, params)
+	assert.NoError(t, err)
 	totalRewardsCost := totalFee + params.BaseReward.Int64()
 	remainder := totalRewardsCost % 4
 
-	assert.Equal(t, totalRewardsCost, s.TotalReward+remainder)
+	// the remainder is burned, so it isn't credited to any account
+	assert.Equal(t, totalRewardsCost-remainder, s.TotalReward)
+	assert.Len(t, s.Rewards, 4)
 
 }
 
@@ -154,8 +189,8 @@ func NewTestRewardParams() Config {
 func createLayer(t testing.TB, mesh *Mesh, id types.LayerID, numOfBlocks, maxTransactions int, atxDB *AtxDbMock) (totalRewards int64, blocks []*types.Block) {
 	for i := 0; i < numOfBlocks; i++ {
 		block1 := types.NewExistingBlock(id, []byte(rand.String(8)), nil)
-		nodeID := types.NodeID{Key: strconv.Itoa(i), VRFPublicKey: []byte("bbbbb")}
-		coinbase := types.HexToAddress(nodeID.Key)
+		nodeID := types.NodeID{Key: nodeKey(i), VRFPublicKey: []byte("bbbbb")}
+		coinbase := types.HexToAddress(strconv.Itoa(i))
 		atx := newActivationTx(nodeID, 0, goldenATXID, 1, 0, goldenATXID, coinbase, 10, []types.BlockID{}, &types.NIPST{})
 		atxDB.AddAtx(atx.ID(), atx)
 		block1.ATXID = atx.ID()
@@ -169,13 +204,18 @@ func createLayer(t testing.TB, mesh *Mesh, id types.LayerID, numOfBlocks, maxTra
 	return totalRewards, blocks
 }
 
+// nodeKey returns a valid Edwards public key of a test smesher
+func nodeKey(i int) string {
+	return fmt.Sprintf("%064x", i)
+}
+
 func TestMesh_integration(t *testing.T) {
 	numOfLayers := 10
 	numOfBlocks := 10
 	maxTxs := 20
 
 	s := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	layers, atxDB := getMeshWithMapState("t1", s)
+	layers, atxDB := getMeshWithMapState(t, "t1", s)
 	defer layers.Close()
 
 	var l3Rewards int64
@@ -208,7 +248,7 @@ func TestMesh_updateStateWithLayer(t *testing.T) {
 	maxTxs := 20
 
 	s := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	mesh, atxDB := getMeshWithMapState("t1", s)
+	mesh, atxDB := getMeshWithMapState(t, "t1", s)
 	defer mesh.Close()
 
 	for i := 0; i < numOfLayers; i++ {
@@ -219,7 +259,7 @@ func TestMesh_updateStateWithLayer(t *testing.T) {
 	}
 
 	s2 := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	mesh2, atxDB2 := getMeshWithMapState("t2", s2)
+	mesh2, atxDB2 := getMeshWithMapState(t, "t2", s2)
 
 	// this should be played until numOfLayers -1 if we want to compare states
 	for i := 0; i < numOfLayers-1; i++ {
@@ -247,7 +287,7 @@ func TestMesh_updateStateWithLayer(t *testing.T) {
 
 	// test that state does not advance when layer x +2 is received before layer x+1, and then test that all layers are pushed
 	s3 := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	mesh3, atxDB3 := getMeshWithMapState("t3", s3)
+	mesh3, atxDB3 := getMeshWithMapState(t, "t3", s3)
 
 	// this should be played until numOfLayers -1 if we want to compare states
 	for i := 0; i < numOfLayers-3; i++ {
@@ -319,7 +359,7 @@ func TestMesh_AccumulateRewards(t *testing.T) {
 	batchSize := 6
 
 	s := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
-	mesh, atxDb := getMeshWithMapState("t1", s)
+	mesh, atxDb := getMeshWithMapState(t, "t1", s)
 	defer mesh.Close()
 
 	mesh.Validator = &meshValidatorBatchMock{mesh: mesh, batchSize: types.LayerID(batchSize)}
@@ -358,12 +398,294 @@ func TestMesh_AccumulateRewards(t *testing.T) {
 		totalPayout-s.TotalReward-int64(numOfBlocks), totalPayout, s.TotalReward, int64(numOfBlocks))
 }
 
+func TestMesh_updateStateWithLayer_Retry(t *testing.T) {
+	r := require.New(t)
+	s := &MockMapState{Rewards: make(map[types.Address]*big.Int), Persisted: 10}
+	mesh, atxDB := getMeshWithMapState(t, "t1", s)
+	defer mesh.Close()
+
+	latest := mesh.LatestLayerInState()
+	var layers []*types.Layer
+	for i := latest + 1; i <= latest+2; i++ {
+		createLayer(t, mesh, i, 3, 5, atxDB)
+		l, err := mesh.GetLayer(i)
+		r.NoError(err)
+		layers = append(layers, l)
+	}
+
+	s.ApplyErr = errors.New("failed to apply")
+	mesh.updateStateWithLayer(latest+1, layers[0])
+	r.Equal(latest, mesh.LatestLayerInState())
+	// what was recorded for the txs of the layer is reverted
+	var txIDs []types.TransactionID
+	for _, b := range layers[0].Blocks() {
+		txIDs = append(txIDs, b.TxIDs...)
+	}
+	r.ElementsMatch(txIDs, s.Reverted)
+	found, err := mesh.hasTransactionRewards(latest + 1)
+	r.NoError(err)
+	r.False(found)
+
+	// the layer that failed is applied again along with the next layer result
+	s.ApplyErr = nil
+	mesh.updateStateWithLayer(latest+2, layers[1])
+	r.Equal(latest+2, mesh.LatestLayerInState())
+	for _, l := range []types.LayerID{latest + 1, latest + 2} {
+		found, err := mesh.hasTransactionRewards(l)
+		r.NoError(err)
+		r.True(found, "layer %v", l)
+	}
+}
+
+func TestMesh_rewindRewards(t *testing.T) {
+	r := require.New(t)
+	s := &MockMapState{Rewards: make(map[types.Address]*big.Int), Persisted: 3}
+	mesh, atxDB := getMeshWithMapState(t, "t1", s)
+	defer mesh.Close()
+
+	var rewound []types.TransactionID
+	for _, l := range []types.LayerID{3, 4, 5} {
+		_, blocks := createLayer(t, mesh, l, 3, 5, atxDB)
+		for _, b := range blocks {
+			if l > 3 {
+				rewound = append(rewound, b.TxIDs...)
+			}
+		}
+	}
+	coinbase := types.HexToAddress("0")
+	smesher := types.NodeID{Key: nodeKey(0), VRFPublicKey: []byte("bbbbb")}
+	testMap := map[types.Address]map[string]uint64{coinbase: {smesher.String(): 1}}
+	for _, l := range []types.LayerID{3, 4, 5} {
+		r.NoError(mesh.setLatestLayerInState(l, &layerRewards{coinbasesAndSmeshers: testMap, blockTotalReward: big.NewInt(10000), blockLayerReward: big.NewInt(9000), layerReward: big.NewInt(9000)}))
+	}
+
+	// the state was persisted at layer 3, so the rewards and transactions of later layers are rolled back with it
+	r.NoError(mesh.loadRecoveredState())
+	r.Equal(types.LayerID(3), mesh.LatestLayerInState())
+	r.ElementsMatch(rewound, s.Reverted)
+	for _, l := range []types.LayerID{4, 5} {
+		found, err := mesh.hasTransactionRewards(l)
+		r.NoError(err)
+		r.False(found, "layer %v", l)
+	}
+	found, err := mesh.hasTransactionRewards(3)
+	r.NoError(err)
+	r.True(found)
+
+	rewards, err := mesh.GetRewards(coinbase)
+	r.NoError(err)
+	r.Len(rewards, 1)
+	r.Equal(types.LayerID(3), rewards[0].Layer)
+	r.Equal(smesher, rewards[0].SmesherID)
+}
+
+func TestMesh_loadRecoveredState(t *testing.T) {
+	r := require.New(t)
+	s := &MockMapState{Rewards: make(map[types.Address]*big.Int), Persisted: 6}
+	mesh, _ := getMeshWithMapState(t, "t1", s)
+	defer mesh.Close()
+
+	r.NoError(mesh.setLatestLayerInState(6, emptyRewards()))
+	r.NoError(mesh.loadRecoveredState())
+	r.Equal(types.LayerID(6), mesh.LatestLayerInState())
+
+	// the node stopped before the states of the layers after the checkpoint were persisted
+	r.NoError(mesh.setLatestLayerInState(8, emptyRewards()))
+	r.NoError(mesh.loadRecoveredState())
+	r.Equal(types.LayerID(6), mesh.LatestLayerInState())
+	latest, err := mesh.getLatestLayerInState()
+	r.NoError(err)
+	r.Equal(types.LayerID(6).Bytes(), latest)
+}
+
+func TestMesh_AccumulateRewards_Remainder(t *testing.T) {
+	for _, tc := range []struct {
+		policy          string
+		remainderBlocks []int // indexes of the blocks that receive a unit of the remainder, in order of block ID
+	}{
+		{policy: RemainderBurn},
+		// the remainder of layer 1 starts from the second block
+		{policy: RemainderRoundRobin, remainderBlocks: []int{1}},
+	} {
+		t.Run(tc.policy, func(t *testing.T) {
+			r := require.New(t)
+			s := &MockMapState{Rewards: make(map[types.Address]*big.Int)}
+			mesh, atxDB := getMeshWithMapState(t, "t1", s)
+			defer mesh.Close()
+
+			// 3 blocks without transactions split a reward of 1000, leaving a remainder of 1
+			_, blocks := createLayer(t, mesh, 1, 3, 1, atxDB)
+			coinbases := make(map[types.BlockID]types.Address)
+			for i, b := range blocks {
+				coinbases[b.ID()] = types.HexToAddress(strconv.Itoa(i))
+			}
+			types.SortBlocks(blocks)
+
+			l, err := mesh.GetLayer(1)
+			r.NoError(err)
+			params := Config{BaseReward: big.NewInt(1000), RemainderPolicy: tc.policy}
+			rewards, err := mesh.accumulateRewards(l, params)
+			r.NoError(err)
+			r.NoError(mesh.setLatestLayerInState(1, rewards))
+			burned := int64(0)
+			if tc.policy == RemainderBurn {
+				burned = 1
+			}
+			r.Equal(1000-burned, s.TotalReward)
+
+			ledger, err := mesh.getRewardLedger(1)
+			r.NoError(err)
+			r.Equal(uint64(1), ledger.Remainder)
+			r.Equal(tc.policy, ledger.RemainderPolicy)
+			r.Len(ledger.RemainderBlocks, len(tc.remainderBlocks))
+			for i, b := range tc.remainderBlocks {
+				r.Equal(blocks[b].ID(), ledger.RemainderBlocks[i])
+			}
+			r.Equal(burned, new(big.Int).SetBytes(ledger.Burned).Int64())
+			r.Len(s.Rewards, len(blocks))
+
+			for i, b := range blocks {
+				expected := uint64(333)
+				for _, rb := range tc.remainderBlocks {
+					if rb == i {
+						expected++
+					}
+				}
+				dbRewards, err := mesh.GetRewards(coinbases[b.ID()])
+				r.NoError(err)
+				r.Len(dbRewards, 1)
+				r.Equal(expected, dbRewards[0].TotalReward)
+			}
+		})
+	}
+}
+
 func TestMesh_calcRewards(t *testing.T) {
 	reward, remainder := calculateActualRewards(1, big.NewInt(10000), big.NewInt(10))
 	assert.Equal(t, int64(1000), reward.Int64())
 	assert.Equal(t, int64(0), remainder.Int64())
 }
 
+func TestMesh_calculateSupply(t *testing.T) {
+	// there are 3 layers per epoch
+	for _, tc := range []struct {
+		name                                string
+		params                              Config
+		layer                               types.LayerID
+		layerReward, epochReward, scheduled int64
+		remaining                           int64 // -1 if the supply isn't capped
+	}{
+		{
+			name:        "base reward",
+			params:      Config{BaseReward: big.NewInt(1000)},
+			layer:       7,
+			layerReward: 1000, epochReward: 3000, scheduled: 8000, remaining: -1,
+		},
+		{
+			name:        "decay",
+			params:      Config{BaseReward: big.NewInt(1000), EpochDecay: 100000},
+			layer:       7,
+			layerReward: 810, epochReward: 2430, scheduled: 3000 + 2700 + 2*810, remaining: -1,
+		},
+		{
+			name:        "halvings",
+			params:      Config{BaseReward: big.NewInt(1000), HalvingInterval: 2},
+			layer:       12,
+			layerReward: 250, epochReward: 750, scheduled: 2*3000 + 2*1500 + 250, remaining: -1,
+		},
+		{
+			name:        "full decay",
+			params:      Config{BaseReward: big.NewInt(1000), EpochDecay: decayDenominator},
+			layer:       5,
+			layerReward: 0, epochReward: 0, scheduled: 3000, remaining: -1,
+		},
+		{
+			name:        "epoch budget",
+			params:      Config{BaseReward: big.NewInt(1000), EpochBudget: big.NewInt(1000)},
+			layer:       4,
+			layerReward: 333, epochReward: 1000, scheduled: 1000 + 334 + 333, remaining: -1,
+		},
+		{
+			name:        "below cap",
+			params:      Config{BaseReward: big.NewInt(1000), SupplyCap: big.NewInt(2500)},
+			layer:       1,
+			layerReward: 1000, epochReward: 2500, scheduled: 2000, remaining: 500,
+		},
+		{
+			name:        "reaching cap",
+			params:      Config{BaseReward: big.NewInt(1000), SupplyCap: big.NewInt(2500)},
+			layer:       2,
+			layerReward: 500, epochReward: 2500, scheduled: 2500, remaining: 0,
+		},
+		{
+			name:        "after cap",
+			params:      Config{BaseReward: big.NewInt(1000), SupplyCap: big.NewInt(2500)},
+			layer:       5,
+			layerReward: 0, epochReward: 0, scheduled: 2500, remaining: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := require.New(t)
+			supply := calculateSupply(tc.layer, tc.params)
+			r.Equal(tc.layer, supply.Layer)
+			r.Equal(tc.layerReward, supply.LayerReward.Int64())
+			r.Equal(tc.epochReward, supply.EpochReward.Int64())
+			r.Equal(tc.scheduled, supply.Scheduled.Int64())
+			if tc.remaining < 0 {
+				r.Nil(supply.Cap)
+			} else {
+				r.Equal(tc.params.SupplyCap, supply.Cap)
+				r.Equal(tc.remaining, new(big.Int).Sub(supply.Cap, supply.Scheduled).Int64())
+			}
+
+			// the schedule resumes from its running totals, and walks from layer 0 again for earlier layers
+			var schedule emissionSchedule
+			for l := types.LayerID(0); l <= tc.layer; l++ {
+				r.Equal(calculateSupply(l, tc.params), schedule.supply(l, tc.params))
+			}
+			r.Equal(supply, schedule.supply(tc.layer, tc.params))
+			r.Equal(calculateSupply(1, tc.params), schedule.supply(1, tc.params))
+			r.Equal(supply, schedule.supply(tc.layer, tc.params))
+		})
+	}
+}
+
+func TestMesh_GetSupply(t *testing.T) {
+	r := require.New(t)
+	s := &MockMapState{Rewards: make(map[types.Address]*big.Int), Persisted: 10}
+	mesh, atxDB := getMeshWithMapState(t, "t1", s)
+	defer mesh.Close()
+	mesh.config = Config{BaseReward: big.NewInt(1000), SupplyCap: big.NewInt(100000)}
+
+	latest := mesh.LatestLayerInState()
+	before, err := mesh.GetSupply(latest)
+	r.NoError(err)
+	r.Zero(before.Emitted.Int64())
+
+	// the first layer has no blocks, so its reward isn't emitted
+	r.NoError(mesh.setLatestLayerInState(latest+1, emptyRewards()))
+	createLayer(t, mesh, latest+2, 3, 1, atxDB)
+	l, err := mesh.GetLayer(latest + 2)
+	r.NoError(err)
+	mesh.updateStateWithLayer(latest+2, l)
+	r.Equal(latest+2, mesh.LatestLayerInState())
+
+	// the 3 blocks split the reward of 1000, and the remainder of 1 is burned
+	supply, err := mesh.GetSupply(latest + 5)
+	r.NoError(err)
+	r.Equal(latest+2, supply.EmittedLayer)
+	r.Equal(int64(999), supply.Emitted.Int64())
+	r.Equal(int64(1), supply.Burned.Int64())
+	r.Equal(int64(100000-1000), supply.Remaining.Int64())
+	r.Equal(int64(1000)*int64(latest+6), supply.Scheduled.Int64())
+
+	supply, err = mesh.GetSupply(latest + 1)
+	r.NoError(err)
+	r.Equal(latest+1, supply.EmittedLayer)
+	r.Zero(supply.Emitted.Int64())
+	r.Zero(supply.Burned.Int64())
+}
+
 func newActivationTx(nodeID types.NodeID, sequence uint64, prevATX types.ATXID, pubLayerID types.LayerID,
 	startTick uint64, positioningATX types.ATXID, coinbase types.Address, activeSetSize uint32, view []types.BlockID,
 	nipst *types.NIPST) *types.ActivationTx {
@@ -378,3 +700,7 @@ func newActivationTx(nodeID types.NodeID, sequence uint64, prevATX types.ATXID,
 	}
 	return types.NewActivationTx(nipstChallenge, coinbase, nipst, 0, nil)
 }
+
+func emptyRewards() *layerRewards {
+	return &layerRewards{blockTotalReward: big.NewInt(0), blockLayerReward: big.NewInt(0), layerReward: big.NewInt(0)}
+}
@@ -0,0 +1,293 @@
+package mesh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/common/util"
+	"github.com/spacemeshos/go-spacemesh/database"
+)
+
+// Snapshot holds the progress of the mesh when a snapshot of it was taken, and the range of layers whose blocks it
+// includes: the requested number of layers up to the latest layer in state, and the processed layers after it.
+type Snapshot struct {
+	FirstLayer     types.LayerID
+	ProcessedLayer types.LayerID
+	VerifiedLayer  types.LayerID // the latest layer in state
+	LayerHash      []byte
+	Tortoise       []byte // the persisted state of the tortoise, without the state of its layers
+	TortoiseLayers []SnapshotTortoiseLayer
+	RewardLedger   []byte // the reward ledger of the latest layer in state, which records the emission so far
+}
+
+// SnapshotTortoiseLayer holds the persisted state of the tortoise's blocks of a layer as written to a snapshot.
+type SnapshotTortoiseLayer struct {
+	Index types.LayerID
+	State []byte
+}
+
+// SnapshotLayer holds a layer of the mesh as written to a snapshot.
+type SnapshotLayer struct {
+	Index        types.LayerID
+	Blocks       []*types.Block
+	Transactions [][]byte        // the transactions included in the blocks, encoded by types.TransactionToBytes
+	Valid        []types.BlockID // the blocks that are contextually valid
+	Invalid      []types.BlockID // the blocks that are contextually invalid
+	InputVector  []types.BlockID
+	Healed       bool            // whether the full tortoise verified the layer
+	HealedVector []types.BlockID // the blocks the full tortoise found valid
+	Hash         types.Hash32    // zero if the layer has no hash
+	RunningHash  types.Hash32    // zero if the layer has no running hash
+}
+
+// ExportSnapshot returns the progress of the mesh, with the first of the given number of layers up to the latest layer
+// in state. If layers is 0, the snapshot includes all layers.
+func (m *DB) ExportSnapshot(layers uint32) (*Snapshot, error) {
+	verified, err := m.getLatestLayerInState()
+	if err != nil {
+		return nil, errors.New("no layer was applied to the state yet")
+	}
+	processed, err := m.general.Get(constPROCESSED)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read processed layer: %v", err)
+	}
+	tortoise, err := m.general.Get(TortoiseHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tortoise state: %v", err)
+	}
+	var tortoiseLayers []SnapshotTortoiseLayer
+	it := m.general.Find(tortoiseLayerPrefix)
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		tortoiseLayers = append(tortoiseLayers, SnapshotTortoiseLayer{
+			Index: types.LayerID(util.BytesToUint64(it.Key()[len(tortoiseLayerPrefix):])),
+			State: append([]byte{}, it.Value()...),
+		})
+	}
+	err = it.Error()
+	it.Release()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tortoise layers: %v", err)
+	}
+	layerHash, err := m.general.Get(constLAYERHASH)
+	if err != nil && err != database.ErrNotFound {
+		return nil, fmt.Errorf("failed to read layer hash: %v", err)
+	}
+	verifiedLayer := types.LayerID(util.BytesToUint64(verified))
+	ledger, err := m.transactions.Get(getRewardLedgerKey(verifiedLayer))
+	if err != nil && err != database.ErrNotFound {
+		return nil, fmt.Errorf("failed to read reward ledger: %v", err)
+	}
+	s := &Snapshot{
+		ProcessedLayer: types.LayerID(util.BytesToUint64(processed)),
+		VerifiedLayer:  verifiedLayer,
+		LayerHash:      layerHash,
+		Tortoise:       tortoise,
+		TortoiseLayers: tortoiseLayers,
+		RewardLedger:   ledger,
+	}
+	if s.ProcessedLayer < s.VerifiedLayer {
+		s.ProcessedLayer = s.VerifiedLayer
+	}
+	if layers > 0 && uint64(s.VerifiedLayer) >= uint64(layers) {
+		s.FirstLayer = s.VerifiedLayer - types.LayerID(layers) + 1
+	}
+	if pruned := m.PrunedLayer(); pruned > 0 && s.FirstLayer <= pruned {
+		return nil, fmt.Errorf("blocks of layers up to %v were pruned, can't export layers from %v", pruned, s.FirstLayer)
+	}
+	return s, nil
+}
+
+// ExportLayer returns the blocks of the layer, with their transactions, validity and the layer's hashes. It returns
+// database.ErrNotFound if no blocks were received for the layer.
+func (m *DB) ExportLayer(index types.LayerID) (*SnapshotLayer, error) {
+	ids, err := m.LayerBlockIds(index)
+	if err != nil {
+		return nil, err
+	}
+	l := &SnapshotLayer{Index: index}
+	var txIDs []types.TransactionID
+	seen := make(map[types.TransactionID]struct{})
+	for _, id := range ids {
+		block, err := m.GetBlock(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read block %v of layer %v: %v", id, index, err)
+		}
+		l.Blocks = append(l.Blocks, block)
+		for _, txID := range block.TxIDs {
+			if _, ok := seen[txID]; !ok {
+				seen[txID] = struct{}{}
+				txIDs = append(txIDs, txID)
+			}
+		}
+		if valid, err := m.ContextualValidity(id); err == nil && valid {
+			l.Valid = append(l.Valid, id)
+		} else if err == nil {
+			l.Invalid = append(l.Invalid, id)
+		}
+	}
+	txs, missing := m.GetTransactions(txIDs)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%d transactions of layer %v are missing", len(missing), index)
+	}
+	for _, tx := range txs {
+		txBytes, err := types.TransactionToBytes(tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tx %v: %v", tx.ID().ShortString(), err)
+		}
+		l.Transactions = append(l.Transactions, txBytes)
+	}
+	if l.InputVector, err = m.defaulGetLayerInputVector(index); err != nil && err != database.ErrNotFound {
+		return nil, fmt.Errorf("failed to read input vector of layer %v: %v", index, err)
+	}
+	if l.HealedVector, err = m.GetLayerHealedVector(index); err == nil {
+		l.Healed = true
+	} else if err != database.ErrNotFound {
+		return nil, fmt.Errorf("failed to read healed vector of layer %v: %v", index, err)
+	}
+	if hash, err := m.general.Get(m.getLayerHashKey(index)); err == nil {
+		l.Hash = types.BytesToHash(hash)
+	}
+	if hash, err := m.getRunningLayerHash(index); err == nil {
+		l.RunningHash = hash
+	}
+	return l, nil
+}
+
+// ImportLayer stores a layer read from a snapshot. If the running hash of the previous layer is known, the layer's
+// running hash must follow from it and from the layer's blocks.
+func (m *DB) ImportLayer(l *SnapshotLayer) error {
+	for _, block := range l.Blocks {
+		block.Initialize()
+		if block.LayerIndex != l.Index {
+			return fmt.Errorf("block %v of layer %v is in layer %v", block.ID(), l.Index, block.LayerIndex)
+		}
+		if err := m.AddBlock(block); err != nil && err != ErrAlreadyExist {
+			return err
+		}
+	}
+	if len(l.Blocks) == 0 {
+		if err := m.AddZeroBlockLayer(l.Index); err != nil {
+			return err
+		}
+	}
+	txs := make([]*types.Transaction, 0, len(l.Transactions))
+	for _, txBytes := range l.Transactions {
+		tx, err := types.BytesToTransaction(txBytes)
+		if err != nil {
+			return fmt.Errorf("failed to decode tx of layer %v: %v", l.Index, err)
+		}
+		if err := tx.CalcAndSetOrigin(); err != nil {
+			return fmt.Errorf("failed to calculate origin of tx %v: %v", tx.ID().ShortString(), err)
+		}
+		txs = append(txs, tx)
+	}
+	if err := m.writeTransactions(l.Index, txs); err != nil {
+		return err
+	}
+	for _, id := range l.Valid {
+		if err := m.SaveContextualValidity(id, true); err != nil {
+			return err
+		}
+	}
+	for _, id := range l.Invalid {
+		if err := m.SaveContextualValidity(id, false); err != nil {
+			return err
+		}
+	}
+	if len(l.InputVector) > 0 {
+		if err := m.SaveLayerInputVector(l.Index, l.InputVector); err != nil {
+			return err
+		}
+	}
+	if l.Healed {
+		if err := m.SaveLayerHealedVector(l.Index, l.HealedVector); err != nil {
+			return err
+		}
+	}
+
+	if l.RunningHash != (types.Hash32{}) {
+		prev, err := m.getRunningLayerHash(l.Index - 1)
+		if l.Index > types.GetEffectiveGenesis() && err == nil {
+			ids, err := m.LayerBlockIds(l.Index)
+			if err != nil {
+				return err
+			}
+			if expected := types.CalcAggregateHash32(prev, types.CalcBlocksHash32(ids, nil).Bytes()); expected != l.RunningHash {
+				return fmt.Errorf("running hash of layer %v is %v, expected %v", l.Index, l.RunningHash.ShortString(), expected.ShortString())
+			}
+		}
+		if err := m.general.Put(m.getRunningLayerHashKey(l.Index), l.RunningHash.Bytes()); err != nil {
+			return err
+		}
+	}
+	if l.Hash != (types.Hash32{}) {
+		if err := m.general.Put(m.getLayerHashKey(l.Index), l.Hash.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportSnapshot stores the progress of the mesh read from a snapshot, after its layers were imported. The valid blocks
+// of the layers up to the latest layer in state are recorded as reported, and the layers before the first layer of the
+// snapshot as pruned.
+func (m *DB) ImportSnapshot(s *Snapshot) error {
+	for l := s.FirstLayer; l <= s.VerifiedLayer; l++ {
+		ids, err := m.LayerBlockIds(l)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var valid []types.BlockID
+		for _, id := range ids {
+			if ok, err := m.ContextualValidity(id); err == nil && ok {
+				valid = append(valid, id)
+			}
+		}
+		bytes, err := types.InterfaceToBytes(types.SortBlockIDs(valid))
+		if err != nil {
+			return err
+		}
+		if err := m.general.Put(m.getValidBlocksKey(l), bytes); err != nil {
+			return err
+		}
+	}
+	if s.FirstLayer > types.GetEffectiveGenesis()+1 {
+		m.prunedMutex.Lock()
+		defer m.prunedMutex.Unlock()
+		if err := m.general.Put(constPRUNED, (s.FirstLayer - 1).Bytes()); err != nil {
+			return err
+		}
+		m.prunedLayer = s.FirstLayer - 1
+	}
+	for _, l := range s.TortoiseLayers {
+		if err := m.general.Put(TortoiseLayerKey(l.Index), l.State); err != nil {
+			return err
+		}
+	}
+	if err := m.general.Put(TortoiseHeader, s.Tortoise); err != nil {
+		return err
+	}
+	if err := m.general.Put(constLAYERHASH, s.LayerHash); err != nil {
+		return err
+	}
+	if len(s.RewardLedger) > 0 {
+		if err := m.transactions.Put(getRewardLedgerKey(s.VerifiedLayer), s.RewardLedger); err != nil {
+			return err
+		}
+	}
+	if err := m.transactions.Put(VERIFIED, s.VerifiedLayer.Bytes()); err != nil {
+		return err
+	}
+	if err := m.general.Put(constPROCESSED, s.ProcessedLayer.Bytes()); err != nil {
+		return err
+	}
+	// the latest layer is written last, since it marks the data as recoverable
+	return m.general.Put(constLATEST, s.ProcessedLayer.Bytes())
+}
@@ -0,0 +1,123 @@
+package mesh
+
+import (
+	"testing"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/signing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeshDB_ExportImportSnapshot(t *testing.T) {
+	r := require.New(t)
+	mdb := newTestMeshDB(t, log.NewDefault(t.Name()))
+	defer mdb.Close()
+
+	first := types.GetEffectiveGenesis() + 1
+	signer := signing.NewEdSigner()
+	var txs []*types.Transaction
+	var prevHash types.Hash32
+	for layer := first; layer < first+3; layer++ {
+		tx, err := types.NewSignedTx(uint64(layer), types.HexToAddress("1"), 10, 100, 1, signer)
+		r.NoError(err)
+		r.NoError(mdb.writeTransactions(layer, []*types.Transaction{tx}))
+		txs = append(txs, tx)
+		for i := 0; i < 2; i++ {
+			block := types.NewExistingBlock(layer, []byte{byte(layer), byte(i)}, []types.TransactionID{tx.ID()})
+			r.NoError(mdb.AddBlock(block))
+			r.NoError(mdb.SaveContextualValidity(block.ID(), i == 0))
+		}
+		ids, err := mdb.LayerBlockIds(layer)
+		r.NoError(err)
+		prevHash = types.CalcAggregateHash32(prevHash, types.CalcBlocksHash32(ids, nil).Bytes())
+		r.NoError(mdb.general.Put(mdb.getRunningLayerHashKey(layer), prevHash.Bytes()))
+	}
+	r.NoError(mdb.general.Put(VERIFIED, (first + 1).Bytes()))
+	r.NoError(mdb.general.Put(constPROCESSED, (first + 2).Bytes()))
+	r.NoError(mdb.general.Put(TortoiseHeader, []byte("tortoise")))
+	r.NoError(mdb.general.Put(TortoiseLayerKey(first+1), []byte("tortoise layer")))
+	// the full tortoise found no valid blocks in a layer, which is told apart from a layer it didn't verify
+	r.NoError(mdb.SaveLayerHealedVector(first+1, []types.BlockID{}))
+	// the keys after the tortoise layers' keys aren't exported as tortoise layers
+	r.NoError(mdb.general.Put([]byte("tortoise_m"), []byte("not a tortoise layer")))
+
+	s, err := mdb.ExportSnapshot(1)
+	r.NoError(err)
+	r.Equal(first+1, s.FirstLayer)
+	r.Equal(first+1, s.VerifiedLayer)
+	r.Equal(first+2, s.ProcessedLayer)
+	r.Equal([]byte("tortoise"), s.Tortoise)
+	r.Equal([]SnapshotTortoiseLayer{{Index: first + 1, State: []byte("tortoise layer")}}, s.TortoiseLayers)
+
+	// the layers are imported from their encoding, like they're read from a snapshot file
+	var encoded [][]byte
+	for l := s.FirstLayer; l <= s.ProcessedLayer; l++ {
+		layer, err := mdb.ExportLayer(l)
+		r.NoError(err)
+		r.Len(layer.Blocks, 2)
+		r.Len(layer.Transactions, 1)
+		r.Len(layer.Valid, 1)
+		r.Len(layer.Invalid, 1)
+		bytes, err := types.InterfaceToBytes(layer)
+		r.NoError(err)
+		encoded = append(encoded, bytes)
+	}
+	decode := func(bytes []byte) *SnapshotLayer {
+		var layer SnapshotLayer
+		r.NoError(types.BytesToInterface(bytes, &layer))
+		return &layer
+	}
+
+	imported := newTestMeshDB(t, log.NewDefault(t.Name()))
+	defer imported.Close()
+	for _, bytes := range encoded {
+		r.NoError(imported.ImportLayer(decode(bytes)))
+	}
+	r.False(imported.PersistentData())
+	r.NoError(imported.ImportSnapshot(s))
+	r.True(imported.PersistentData())
+	r.Equal(first, imported.PrunedLayer())
+	tortoiseLayer, err := imported.general.Get(TortoiseLayerKey(first + 1))
+	r.NoError(err)
+	r.Equal([]byte("tortoise layer"), tortoiseLayer)
+	healed, err := imported.GetLayerHealedVector(first + 1)
+	r.NoError(err)
+	r.Empty(healed)
+	_, err = imported.GetLayerHealedVector(first + 2)
+	r.Equal(database.ErrNotFound, err)
+
+	for i, l := range []types.LayerID{first + 1, first + 2} {
+		ids, err := mdb.LayerBlockIds(l)
+		r.NoError(err)
+		importedIDs, err := imported.LayerBlockIds(l)
+		r.NoError(err)
+		r.ElementsMatch(ids, importedIDs)
+		for _, id := range ids {
+			valid, err := mdb.ContextualValidity(id)
+			r.NoError(err)
+			importedValid, err := imported.ContextualValidity(id)
+			r.NoError(err)
+			r.Equal(valid, importedValid)
+		}
+		tx, err := imported.GetTransaction(txs[i+1].ID())
+		r.NoError(err)
+		r.Equal(txs[i+1].Origin(), tx.Origin())
+		hash, err := mdb.getRunningLayerHash(l)
+		r.NoError(err)
+		importedHash, err := imported.getRunningLayerHash(l)
+		r.NoError(err)
+		r.Equal(hash, importedHash)
+	}
+	_, err = imported.LayerBlocks(first)
+	r.Equal(ErrLayerPruned, err)
+
+	// a layer whose running hash doesn't follow from the previous layer's is rejected
+	corrupted := newTestMeshDB(t, log.NewDefault(t.Name()))
+	defer corrupted.Close()
+	r.NoError(corrupted.ImportLayer(decode(encoded[0])))
+	layer := decode(encoded[1])
+	layer.RunningHash = types.Hash32{1}
+	r.Error(corrupted.ImportLayer(layer))
+}
@@ -0,0 +1,159 @@
+package mesh
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/common/util"
+	"github.com/spacemeshos/go-spacemesh/database"
+)
+
+// Verify checks that the mesh databases agree with each other: every block body is listed in its layer, every block
+// listed in a layer that wasn't pruned has a body, and the running layer hashes of the layers applied to the state
+// follow from the layers' blocks. checkBlock, if not nil, is called with each block body for additional checks, and the
+// error it returns is reported as an inconsistency. All the inconsistencies found are returned, and err is only set if
+// the databases couldn't be read.
+//
+// With repair, the data derived from the block bodies is rebuilt: missing block IDs are added to their layers, the
+// explorer indexes are rewritten if ExplorerIndex is set, and the running layer hashes that don't match are recomputed.
+// Inconsistencies that can't be repaired, like missing block bodies, are only reported.
+func (m *DB) Verify(repair bool, checkBlock func(*types.Block) error) (problems []error, err error) {
+	bodies := make(map[types.BlockID]types.LayerID)
+	it := m.blocks.Find(nil)
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		// the blocks db also holds the smesher index, whose keys are longer than block IDs
+		if len(it.Key()) != len(types.Hash32{}) {
+			continue
+		}
+		block := &types.Block{}
+		if err := types.BytesToInterface(it.Value(), block); err != nil {
+			problems = append(problems, fmt.Errorf("block %x can't be decoded: %v", it.Key(), err))
+			continue
+		}
+		block.Initialize()
+		if id := block.ID(); !bytes.Equal(id.Bytes(), it.Key()) {
+			problems = append(problems, fmt.Errorf("block %x is stored under the ID of another block (%v)", it.Key(), id))
+			continue
+		}
+		bodies[block.ID()] = block.Layer()
+		problems = append(problems, m.verifyBlock(block, repair)...)
+		if checkBlock != nil {
+			if err := checkBlock(block); err != nil {
+				problems = append(problems, fmt.Errorf("block %v in layer %v: %v", block.ID(), block.Layer(), err))
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return problems, fmt.Errorf("failed to read blocks: %v", err)
+	}
+
+	pruned := m.PrunedLayer()
+	it = m.layers.Find(nil)
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		layer := types.LayerID(util.BytesToUint64(it.Key()))
+		if len(it.Value()) == 0 {
+			continue // a layer without blocks
+		}
+		ids, err := types.BytesToBlockIds(it.Value())
+		if err != nil {
+			problems = append(problems, fmt.Errorf("block IDs of layer %v can't be decoded: %v", layer, err))
+			continue
+		}
+		for _, id := range ids {
+			bodyLayer, ok := bodies[id]
+			switch {
+			case !ok && (pruned == 0 || layer > pruned):
+				problems = append(problems, fmt.Errorf("block %v of layer %v has no body", id, layer))
+			case ok && bodyLayer != layer:
+				problems = append(problems, fmt.Errorf("block %v of layer %v is listed in layer %v", id, bodyLayer, layer))
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return problems, fmt.Errorf("failed to read layers: %v", err)
+	}
+
+	return append(problems, m.verifyRunningLayerHashes(repair)...), nil
+}
+
+// verifyBlock checks that the block is listed in its layer, and with repair, adds it if it isn't and rewrites its
+// explorer indexes.
+func (m *DB) verifyBlock(block *types.Block, repair bool) (problems []error) {
+	ids, err := m.LayerBlockIds(block.Layer())
+	if err != nil && err != database.ErrNotFound {
+		return []error{fmt.Errorf("block IDs of layer %v can't be read: %v", block.Layer(), err)}
+	}
+	listed := false
+	for _, id := range ids {
+		if id == block.ID() {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		problems = append(problems, fmt.Errorf("block %v isn't listed in layer %v", block.ID(), block.Layer()))
+		if repair {
+			if err := m.updateLayerWithBlock(block); err != nil {
+				problems = append(problems, fmt.Errorf("failed to add block %v to layer %v: %v", block.ID(), block.Layer(), err))
+			}
+		}
+	}
+	if repair && m.ExplorerIndex {
+		if err := m.writeBlockIndexes(block); err != nil {
+			problems = append(problems, fmt.Errorf("failed to index block %v: %v", block.ID(), err))
+		}
+	}
+	return problems
+}
+
+// verifyRunningLayerHashes checks the running layer hashes up to the latest layer in state, starting from the first
+// layer that has one, since the layers of the genesis epochs usually don't. Each hash must be the aggregate of the hash
+// the previous layer should have and the hash of the layer's blocks. With repair, the hashes that don't match are
+// rewritten.
+func (m *DB) verifyRunningLayerHashes(repair bool) (problems []error) {
+	latest, err := m.getLatestLayerInState()
+	if err != nil {
+		return nil // no layer was applied to the state yet
+	}
+	latestInState := types.LayerID(util.BytesToUint64(latest))
+	started := false
+	var prevHash types.Hash32
+	for layer := types.LayerID(0); layer <= latestInState; layer++ {
+		hash, err := m.getRunningLayerHash(layer)
+		if err != nil && !started {
+			continue
+		}
+		started = true
+		ids, idsErr := m.LayerBlockIds(layer)
+		if idsErr != nil && idsErr != database.ErrNotFound {
+			return append(problems, fmt.Errorf("block IDs of layer %v can't be read: %v", layer, idsErr))
+		}
+		if layer <= types.GetEffectiveGenesis() {
+			prevHash = types.Hash32{}
+		}
+		expected := types.CalcAggregateHash32(prevHash, types.CalcBlocksHash32(ids, nil).Bytes())
+		prevHash = expected
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Errorf("layer %v has no running layer hash", layer))
+		case hash != expected:
+			problems = append(problems, fmt.Errorf("running layer hash of layer %v is %v, expected %v",
+				layer, hash.ShortString(), expected.ShortString()))
+		default:
+			continue
+		}
+		if repair {
+			if err := m.general.Put(m.getRunningLayerHashKey(layer), expected.Bytes()); err != nil {
+				return append(problems, fmt.Errorf("failed to repair running layer hash of layer %v: %v", layer, err))
+			}
+		}
+	}
+	return problems
+}
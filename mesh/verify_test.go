@@ -0,0 +1,76 @@
+package mesh
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeshDB_Verify(t *testing.T) {
+	r := require.New(t)
+	mdb := newTestMeshDB(t, log.NewDefault(t.Name()))
+	defer mdb.Close()
+
+	first := types.GetEffectiveGenesis() + 1
+	var blocks []*types.Block
+	var prevHash types.Hash32
+	for layer := first; layer < first+3; layer++ {
+		for i := 0; i < 2; i++ {
+			block := types.NewExistingBlock(layer, []byte{byte(layer), byte(i)}, nil)
+			r.NoError(mdb.AddBlock(block))
+			blocks = append(blocks, block)
+		}
+		ids, err := mdb.LayerBlockIds(layer)
+		r.NoError(err)
+		prevHash = types.CalcAggregateHash32(prevHash, types.CalcBlocksHash32(ids, nil).Bytes())
+		r.NoError(mdb.general.Put(mdb.getRunningLayerHashKey(layer), prevHash.Bytes()))
+	}
+	r.NoError(mdb.general.Put(VERIFIED, (first + 2).Bytes()))
+
+	problems, err := mdb.Verify(false, nil)
+	r.NoError(err)
+	r.Empty(problems)
+
+	// drop a block from its layer and corrupt a running hash, both can be repaired
+	ids, err := mdb.LayerBlockIds(first + 1)
+	r.NoError(err)
+	r.Len(ids, 2)
+	encoded, err := types.BlockIdsToBytes(ids[:1])
+	r.NoError(err)
+	r.NoError(mdb.layers.Put((first + 1).Bytes(), encoded))
+	r.NoError(mdb.general.Put(mdb.getRunningLayerHashKey(first), types.Hash32{}.Bytes()))
+
+	problems, err = mdb.Verify(false, nil)
+	r.NoError(err)
+	// the dropped block also changes the expected hashes of its layer and of the following one
+	r.Len(problems, 4)
+	// the block is added back to its layer before the hashes are checked
+	problems, err = mdb.Verify(true, nil)
+	r.NoError(err)
+	r.Len(problems, 2)
+	problems, err = mdb.Verify(false, nil)
+	r.NoError(err)
+	r.Empty(problems)
+
+	// a missing block body can't be repaired
+	r.NoError(mdb.blocks.Delete(blocks[0].ID().Bytes()))
+	problems, err = mdb.Verify(true, nil)
+	r.NoError(err)
+	r.Len(problems, 1)
+
+	// the block check is called with every block body
+	checked := 0
+	problems, err = mdb.Verify(false, func(b *types.Block) error {
+		checked++
+		if b.ID() == blocks[1].ID() {
+			return errors.New("bad block")
+		}
+		return nil
+	})
+	r.NoError(err)
+	r.Len(problems, 2)
+	r.Equal(len(blocks)-1+len(GenesisLayer().Blocks()), checked)
+}
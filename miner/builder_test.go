@@ -351,7 +351,7 @@ func NewTx(t *testing.T, nonce uint64, recipient types.Address, signer *signing.
 
 func TestBlockBuilder_SerializeTrans(t *testing.T) {
 	tx := NewTx(t, 1, types.BytesToAddress([]byte{0x02}), signing.NewEdSigner())
-	buf, err := types.InterfaceToBytes(tx)
+	buf, err := types.TransactionToBytes(tx)
 	assert.NoError(t, err)
 
 	ntx, err := types.BytesToTransaction(buf)
@@ -398,7 +398,7 @@ func TestBlockBuilder_Validation(t *testing.T) {
 	builder1 := NewBlockBuilder(types.NodeID{Key: "a"}, signing.NewEdSigner(), n1, beginRound, 5, state.NewTxMemPool(), activation.NewAtxMemPool(), MockCoin{}, &mockMesh{b: st}, hare, &mockBlockOracle{}, mockTxProcessor{true}, &mockAtxValidator{}, &mockSyncer{}, selectCount, selectCount, layersPerEpoch, mockProjector, log.New(n1.Info.ID.String(), "", ""))
 	assert.NoError(t, builder1.Start())
 	tx := NewTx(t, 5, types.HexToAddress("0xFF"), signing.NewEdSigner())
-	b, e := types.InterfaceToBytes(tx)
+	b, e := types.TransactionToBytes(tx)
 	assert.Nil(t, e)
 	assert.NoError(t, n1.Broadcast(IncomingTxProtocol, b))
 	time.Sleep(300 * time.Millisecond)
@@ -446,7 +446,7 @@ func TestBlockBuilder_Gossip_NotSynced(t *testing.T) {
 		""))
 	assert.NoError(t, builder1.Start())
 	tx := NewTx(t, 5, types.HexToAddress("0xFF"), signing.NewEdSigner())
-	b, e := types.InterfaceToBytes(tx)
+	b, e := types.TransactionToBytes(tx)
 	assert.Nil(t, e)
 	err := n1.Broadcast(IncomingTxProtocol, b)
 	assert.NoError(t, err)
@@ -6,12 +6,13 @@ package pendingtxs
 import (
 	"bytes"
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"math"
 	"sync"
 )
 
 type nanoTx struct {
-	Amount                 uint64
-	Fee                    uint64
+	Amount                 uint64 // the total amount of all the tx's transfers
+	Fee                    uint64 // the fee for the tx's intrinsic gas, not its gas price
 	HighestLayerIncludedIn types.LayerID
 }
 
@@ -42,9 +43,17 @@ func (apt *AccountPendingTxs) Add(layer types.LayerID, txs ...*types.Transaction
 		if existing[tx.ID()].HighestLayerIncludedIn > layer {
 			layer = existing[tx.ID()].HighestLayerIncludedIn
 		}
+		fee, ok := tx.GasFee(tx.IntrinsicGas())
+		if !ok {
+			fee = math.MaxUint64 // the tx can never be applied
+		}
+		amount, ok := tx.TotalAmount()
+		if !ok {
+			amount = math.MaxUint64 // the tx can never be applied
+		}
 		existing[tx.ID()] = nanoTx{
-			Amount:                 tx.Amount,
-			Fee:                    tx.Fee,
+			Amount:                 amount,
+			Fee:                    fee,
 			HighestLayerIncludedIn: layer,
 		}
 	}
@@ -92,6 +101,57 @@ func (apt *AccountPendingTxs) RemoveNonce(nonce uint64, deleteTx func(id types.T
 	apt.mu.Unlock()
 }
 
+// RemoveTx removes a single transaction with the given nonce and ID from the AccountPendingTxs, leaving any other
+// version of the transaction with the same nonce in place.
+func (apt *AccountPendingTxs) RemoveTx(nonce uint64, id types.TransactionID) {
+	apt.mu.Lock()
+	if existing, found := apt.PendingTxs[nonce]; found {
+		delete(existing, id)
+		if len(existing) == 0 {
+			delete(apt.PendingTxs, nonce)
+		}
+	}
+	apt.mu.Unlock()
+}
+
+// TxIdsWithNonce returns the IDs of all the transactions with the given nonce.
+func (apt *AccountPendingTxs) TxIdsWithNonce(nonce uint64) []types.TransactionID {
+	apt.mu.RLock()
+	defer apt.mu.RUnlock()
+	var ids []types.TransactionID
+	for id := range apt.PendingTxs[nonce] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// HighestNonce returns the highest nonce with pending transactions and the IDs of all transactions with that nonce.
+// found is false if there are no pending transactions.
+func (apt *AccountPendingTxs) HighestNonce() (nonce uint64, ids []types.TransactionID, found bool) {
+	apt.mu.RLock()
+	defer apt.mu.RUnlock()
+	for n := range apt.PendingTxs {
+		if !found || n > nonce {
+			nonce, found = n, true
+		}
+	}
+	for id := range apt.PendingTxs[nonce] {
+		ids = append(ids, id)
+	}
+	return nonce, ids, found
+}
+
+// Count returns the number of transactions in this object, including all versions of each nonce.
+func (apt *AccountPendingTxs) Count() int {
+	apt.mu.RLock()
+	defer apt.mu.RUnlock()
+	count := 0
+	for _, txs := range apt.PendingTxs {
+		count += len(txs)
+	}
+	return count
+}
+
 // GetProjection provides projected nonce and balance after valid transactions in the AccountPendingTxs would be
 // applied. Since determining which transactions are valid depends on the previous nonce and balance, those must be
 // provided.
@@ -136,7 +196,7 @@ func validTxWithHighestFee(txs map[types.TransactionID]nanoTx, balance uint64) t
 	var maxFee uint64
 	for id, tx := range txs {
 		if (tx.Fee > maxFee || (tx.Fee == maxFee && bytes.Compare(id[:], bestID[:]) < 0)) &&
-			balance >= (tx.Amount+tx.Fee) {
+			tx.Fee <= balance && tx.Amount <= balance-tx.Fee {
 
 			maxFee = tx.Fee
 			bestID = id
@@ -154,3 +154,59 @@ func TestNewAccountPendingTxs(t *testing.T) {
 	r.Equal(int(prevNonce)+2, int(nonce))
 	r.Equal(prevBalance-50-950, balance)
 }
+
+func TestAccountPendingTxs_HighestNonceAndRemoveTx(t *testing.T) {
+	r := require.New(t)
+
+	pendingTxs := NewAccountPendingTxs()
+	_, _, found := pendingTxs.HighestNonce()
+	r.False(found)
+	r.Equal(0, pendingTxs.Count())
+
+	tx1 := newTx(t, 5, 100, 1)
+	tx2 := newTx(t, 6, 100, 1)
+	tx3 := newTx(t, 6, 100, 2)
+	pendingTxs.Add(0, tx1, tx2, tx3)
+	r.Equal(3, pendingTxs.Count())
+
+	nonce, ids, found := pendingTxs.HighestNonce()
+	r.True(found)
+	r.Equal(uint64(6), nonce)
+	r.ElementsMatch([]types.TransactionID{tx2.ID(), tx3.ID()}, ids)
+
+	// Removing one version of a nonce leaves the others in place
+	pendingTxs.RemoveTx(6, tx3.ID())
+	r.Equal(2, pendingTxs.Count())
+	nonce, ids, _ = pendingTxs.HighestNonce()
+	r.Equal(uint64(6), nonce)
+	r.Equal([]types.TransactionID{tx2.ID()}, ids)
+
+	pendingTxs.RemoveTx(6, tx2.ID())
+	nonce, ids, _ = pendingTxs.HighestNonce()
+	r.Equal(uint64(5), nonce)
+	r.Equal([]types.TransactionID{tx1.ID()}, ids)
+
+	pendingTxs.RemoveTx(5, tx1.ID())
+	r.True(pendingTxs.IsEmpty())
+}
+
+func TestAccountPendingTxs_GetProjection_Batch(t *testing.T) {
+	r := require.New(t)
+	signer := signing.NewEdSigner()
+
+	pendingTxs := NewAccountPendingTxs()
+	transfers := []types.Transfer{{Recipient: types.Address{1}, Amount: 100}, {Recipient: types.Address{2}, Amount: 200}}
+	batch, err := types.NewSignedBatchTx(5, transfers, 10, 2, signer)
+	r.NoError(err)
+	pendingTxs.Add(1, batch)
+
+	// the projection subtracts the amounts of all the transfers and the fee for all of them
+	nonce, balance := pendingTxs.GetProjection(5, 1000)
+	r.Equal(uint64(6), nonce)
+	r.Equal(uint64(1000-300-2*2*types.GasTransfer), balance)
+
+	// a batch whose total the account can't pay isn't projected at all
+	nonce, balance = pendingTxs.GetProjection(5, 300)
+	r.Equal(uint64(5), nonce)
+	r.Equal(uint64(300), balance)
+}
@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// A snapshot file starts with magic, followed by records, and ends with the sha256 checksum of everything before it.
+// Each record is a kind byte, the big endian uint32 length of its payload and the payload. The first record is always
+// the header.
+var magic = []byte("spacemesh-snapshot\n")
+
+const checksumLength = sha256.Size
+
+// maxRecordLength bounds the memory a corrupted length can make the reader allocate.
+const maxRecordLength = 256 << 20
+
+const (
+	recordHeader byte = iota + 1
+	recordMesh
+	recordLayer
+	recordAtx
+	recordPoetProof
+	recordStateNode
+	recordPreimage
+)
+
+// ErrChecksum is returned when importing a snapshot whose content doesn't match its checksum.
+var ErrChecksum = errors.New("snapshot checksum mismatch")
+
+type writer struct {
+	w    io.Writer
+	out  io.Writer
+	hash hash.Hash
+}
+
+func newWriter(out io.Writer) (*writer, error) {
+	h := sha256.New()
+	w := &writer{w: io.MultiWriter(out, h), out: out, hash: h}
+	if _, err := w.w.Write(magic); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *writer) writeRecord(kind byte, payload []byte) error {
+	if len(payload) > maxRecordLength {
+		return fmt.Errorf("record of %d bytes is too long", len(payload))
+	}
+	var prefix [5]byte
+	prefix[0] = kind
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+	if _, err := w.w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(payload)
+	return err
+}
+
+// finish writes the checksum of everything written so far.
+func (w *writer) finish() error {
+	_, err := w.out.Write(w.hash.Sum(nil))
+	return err
+}
+
+type reader struct {
+	r *bufio.Reader
+}
+
+// newReader checks the checksum of the snapshot and returns a reader of its records.
+func newReader(r io.ReadSeeker) (*reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if size < int64(len(magic)+checksumLength) {
+		return nil, errors.New("snapshot is too short")
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if _, err := io.CopyN(h, r, size-checksumLength); err != nil {
+		return nil, err
+	}
+	checksum := make([]byte, checksumLength)
+	if _, err := io.ReadFull(r, checksum); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(checksum, h.Sum(nil)) {
+		return nil, ErrChecksum
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	rd := &reader{r: bufio.NewReader(io.LimitReader(r, size-checksumLength))}
+	prefix := make([]byte, len(magic))
+	if _, err := io.ReadFull(rd.r, prefix); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(prefix, magic) {
+		return nil, errors.New("not a snapshot file")
+	}
+	return rd, nil
+}
+
+// next returns the next record, or io.EOF after the last one.
+func (r *reader) next() (kind byte, payload []byte, err error) {
+	kind, err = r.r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var length [4]byte
+	if _, err := io.ReadFull(r.r, length[:]); err != nil {
+		return 0, nil, fmt.Errorf("truncated record: %v", err)
+	}
+	n := binary.BigEndian.Uint32(length[:])
+	if n > maxRecordLength {
+		return 0, nil, fmt.Errorf("record of %d bytes is too long", n)
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(r.r, payload); err != nil {
+		return 0, nil, fmt.Errorf("truncated record: %v", err)
+	}
+	return kind, payload, nil
+}
@@ -0,0 +1,41 @@
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotFormat(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	w, err := newWriter(&buf)
+	r.NoError(err)
+	records := [][]byte{[]byte("header"), {}, bytes.Repeat([]byte{7}, 5000)}
+	for i, payload := range records {
+		r.NoError(w.writeRecord(byte(i+1), payload))
+	}
+	r.NoError(w.finish())
+	snapshot := buf.Bytes()
+
+	rd, err := newReader(bytes.NewReader(snapshot))
+	r.NoError(err)
+	for i, payload := range records {
+		kind, read, err := rd.next()
+		r.NoError(err)
+		r.Equal(byte(i+1), kind)
+		r.Equal(payload, read)
+	}
+	_, _, err = rd.next()
+	r.Equal(io.EOF, err)
+
+	corrupted := append([]byte{}, snapshot...)
+	corrupted[len(magic)+3]++
+	_, err = newReader(bytes.NewReader(corrupted))
+	r.Equal(ErrChecksum, err)
+
+	_, err = newReader(bytes.NewReader(snapshot[:len(snapshot)-1]))
+	r.Error(err)
+}
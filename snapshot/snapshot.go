@@ -0,0 +1,210 @@
+// Package snapshot exports the databases of a node to a portable file, from which a new node can be bootstrapped
+// instead of syncing the mesh from genesis.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spacemeshos/go-spacemesh/activation"
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+	"github.com/spacemeshos/go-spacemesh/state"
+)
+
+// Version is the version of the snapshot format written by Export. Snapshots of other versions can't be imported.
+const Version = 3
+
+// Header describes the content of a snapshot.
+type Header struct {
+	Version    uint32
+	Layer      types.LayerID // the latest layer in state when the snapshot was taken
+	StateRoot  types.Hash32  // the state root of Layer
+	FirstLayer types.LayerID // the first layer whose blocks are included
+	LastLayer  types.LayerID // the last layer whose blocks are included
+}
+
+// Export writes a snapshot of the node's databases to w: the state trie of the latest layer in state, the blocks and
+// transactions of the given number of layers up to it and of the processed layers after it, all the ATXs and the PoET
+// proofs they reference. If layers is 0, the blocks of all layers are included.
+func Export(w io.Writer, mdb *mesh.DB, atxdb *activation.DB, poetDb *activation.PoetDb, processor *state.TransactionProcessor, layers uint32) (*Header, error) {
+	progress, err := mdb.ExportSnapshot(layers)
+	if err != nil {
+		return nil, err
+	}
+	root, err := processor.GetLayerStateRoot(progress.VerifiedLayer)
+	if err != nil {
+		return nil, fmt.Errorf("no state root for layer %v: %v", progress.VerifiedLayer, err)
+	}
+	header := &Header{
+		Version:    Version,
+		Layer:      progress.VerifiedLayer,
+		StateRoot:  root,
+		FirstLayer: progress.FirstLayer,
+		LastLayer:  progress.ProcessedLayer,
+	}
+
+	sw, err := newWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	write := func(kind byte, v interface{}) error {
+		bytes, err := types.InterfaceToBytes(v)
+		if err != nil {
+			return err
+		}
+		return sw.writeRecord(kind, bytes)
+	}
+	if err := write(recordHeader, header); err != nil {
+		return nil, err
+	}
+	if err := write(recordMesh, progress); err != nil {
+		return nil, err
+	}
+
+	for l := header.FirstLayer; l <= header.LastLayer; l++ {
+		layer, err := mdb.ExportLayer(l)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := write(recordLayer, layer); err != nil {
+			return nil, err
+		}
+	}
+
+	proofs := make(map[types.Hash32]struct{})
+	err = atxdb.ExportAtxs(func(atx *types.ActivationTx) error {
+		if err := write(recordAtx, atx); err != nil {
+			return err
+		}
+		if atx.Nipst == nil || atx.Nipst.PostProof == nil {
+			return nil
+		}
+		ref := atx.GetPoetProofRef()
+		if _, ok := proofs[ref]; ok {
+			return nil
+		}
+		proofs[ref] = struct{}{}
+		msg, err := poetDb.GetProofMessage(ref.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to read poet proof of atx %v: %v", atx.ShortString(), err)
+		}
+		return sw.writeRecord(recordPoetProof, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = processor.ExportState(header.Layer, func(hash types.Hash32, blob []byte) error {
+		return sw.writeRecord(recordStateNode, append(hash.Bytes(), blob...))
+	}, func(addr []byte) error {
+		return sw.writeRecord(recordPreimage, addr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return header, sw.finish()
+}
+
+// Import bootstraps the databases of a node that has no mesh yet from a snapshot. The snapshot is only trusted if its
+// state root is the given one, which must be obtained from a trusted source, since the snapshot can't vouch for itself.
+// The checksum and the state root of the snapshot are checked before anything is written, and the state trie nodes and
+// layers are checked against the state root and the running layer hashes as they're imported. If the import fails, the
+// databases are left with what was imported so far.
+func Import(r io.ReadSeeker, stateRoot types.Hash32, mdb *mesh.DB, atxdb *activation.DB, poetDb *activation.PoetDb, processor *state.TransactionProcessor) (*Header, error) {
+	sr, err := newReader(r)
+	if err != nil {
+		return nil, err
+	}
+	kind, payload, err := sr.next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot header: %v", err)
+	}
+	var header Header
+	if kind != recordHeader {
+		return nil, fmt.Errorf("snapshot starts with record of kind %d instead of the header", kind)
+	}
+	if err := types.BytesToInterface(payload, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot header: %v", err)
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %d, expected %d", header.Version, Version)
+	}
+	if header.StateRoot != stateRoot {
+		return nil, fmt.Errorf("snapshot has state root %v, expected %v", header.StateRoot.Hex(), stateRoot.Hex())
+	}
+	if mdb.PersistentData() {
+		return nil, errors.New("the node already has a mesh, a snapshot can only be imported to an empty data folder")
+	}
+
+	stateImport := processor.NewStateImport(header.Layer, header.StateRoot)
+	var progress *mesh.Snapshot
+	for {
+		kind, payload, err := sr.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case recordMesh:
+			progress = &mesh.Snapshot{}
+			if err := types.BytesToInterface(payload, progress); err != nil {
+				return nil, fmt.Errorf("failed to decode mesh progress: %v", err)
+			}
+		case recordLayer:
+			var layer mesh.SnapshotLayer
+			if err := types.BytesToInterface(payload, &layer); err != nil {
+				return nil, fmt.Errorf("failed to decode layer: %v", err)
+			}
+			if err := mdb.ImportLayer(&layer); err != nil {
+				return nil, fmt.Errorf("failed to import layer %v: %v", layer.Index, err)
+			}
+		case recordAtx:
+			atx, err := types.BytesToAtx(payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode atx: %v", err)
+			}
+			if err := atxdb.ImportAtx(atx); err != nil {
+				return nil, err
+			}
+		case recordPoetProof:
+			if err := poetDb.ValidateAndStoreMsg(payload); err != nil {
+				return nil, fmt.Errorf("failed to import poet proof: %v", err)
+			}
+		case recordStateNode:
+			if len(payload) < types.Hash32Length {
+				return nil, fmt.Errorf("state node record of %d bytes is too short", len(payload))
+			}
+			if err := stateImport.AddNode(types.BytesToHash(payload[:types.Hash32Length]), payload[types.Hash32Length:]); err != nil {
+				return nil, err
+			}
+		case recordPreimage:
+			if err := stateImport.AddPreimage(payload); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown snapshot record kind %d", kind)
+		}
+	}
+
+	if progress == nil {
+		return nil, errors.New("snapshot has no mesh progress")
+	}
+	if progress.VerifiedLayer != header.Layer {
+		return nil, fmt.Errorf("mesh progress is at layer %v, the state at layer %v", progress.VerifiedLayer, header.Layer)
+	}
+	if err := stateImport.Finish(); err != nil {
+		return nil, err
+	}
+	if err := mdb.ImportSnapshot(progress); err != nil {
+		return nil, fmt.Errorf("failed to import mesh progress: %v", err)
+	}
+	return &header, nil
+}
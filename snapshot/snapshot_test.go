@@ -0,0 +1,27 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+)
+
+func TestImport_StateRootMismatch(t *testing.T) {
+	r := require.New(t)
+	var buf bytes.Buffer
+	w, err := newWriter(&buf)
+	r.NoError(err)
+	header := Header{Version: Version, Layer: 10, StateRoot: types.CalcHash32([]byte("root"))}
+	payload, err := types.InterfaceToBytes(&header)
+	r.NoError(err)
+	r.NoError(w.writeRecord(recordHeader, payload))
+	r.NoError(w.finish())
+
+	// nothing is written to the databases, so they aren't needed
+	_, err = Import(bytes.NewReader(buf.Bytes()), types.CalcHash32([]byte("other")), nil, nil, nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "snapshot has state root "+header.StateRoot.Hex())
+}
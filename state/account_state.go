@@ -64,7 +64,7 @@ func (state *Object) touch() {
 
 // empty returns whether the account is considered empty.
 func (state *Object) empty() bool {
-	return state.account.Nonce == 0 && state.account.Balance == 0
+	return state.account.Nonce == 0 && state.account.Balance == 0 && len(state.account.Control) == 0
 }
 
 // SubBalance removes amount from c's balance.
@@ -124,6 +124,21 @@ func (state *Object) Nonce() uint64 {
 	return state.account.Nonce
 }
 
+// Control returns the rules for spending from a multisig or vault account, or nil for simple accounts
+func (state *Object) Control() *types.AccountControl {
+	if len(state.account.Control) == 0 {
+		return nil
+	}
+	control := state.account.Control[0]
+	return &control
+}
+
+// SetControl turns this Object into a multisig or vault account, spent according to control
+func (state *Object) SetControl(control types.AccountControl) {
+	state.account.Control = []types.AccountControl{control}
+	state.db.makeDirtyObj(state)
+}
+
 // Value Never called, but must be present to allow Object to be used
 // as a vm.Account interface that also satisfies the vm.ContractRef
 // interface. Interfaces are awesome.
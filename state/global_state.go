@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/crypto"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/rlp"
 	"github.com/spacemeshos/go-spacemesh/trie"
@@ -106,6 +107,16 @@ func (state *DB) GetNonce(addr types.Address) uint64 {
 	return 0
 }
 
+// GetAccountControl returns the rules for spending from a multisig or vault account, or nil if addr is a simple account
+// or isn't found
+func (state *DB) GetAccountControl(addr types.Address) *types.AccountControl {
+	StateObj := state.getStateObj(addr)
+	if StateObj != nil {
+		return StateObj.Control()
+	}
+	return nil
+}
+
 /*
  * SETTERS
  */
@@ -142,6 +153,14 @@ func (state *DB) SetNonce(addr types.Address, nonce uint64) {
 	}
 }
 
+// SetAccountControl makes the account associated with addr a multisig or vault account, spent according to control
+func (state *DB) SetAccountControl(addr types.Address, control types.AccountControl) {
+	stateObj := state.GetOrNewStateObj(addr)
+	if stateObj != nil {
+		stateObj.SetControl(control)
+	}
+}
+
 //
 // Setting, updating & deleting state object methods.
 //
@@ -179,6 +198,10 @@ func (state *DB) getStateObj(addr types.Address) (StateObj *Object) {
 		log.Error("Failed to decode state object", "addr", addr, "err", err)
 		return nil
 	}
+	if len(data.Control) == 0 {
+		// the tail of a simple account decodes as an empty slice
+		data.Control = nil
+	}
 	// Insert into the live set.
 	obj := newObject(state, addr, data)
 	state.setStateObj(obj)
@@ -289,3 +312,22 @@ func (state *DB) IntermediateRoot(deleteEmptyObjects bool) types.Hash32 {
 func (state *DB) TrieDB() *trie.Database {
 	return state.db.TrieDB()
 }
+
+// GetProof returns the Merkle proof of the account associated with addr against the state's committed root: the
+// encoded trie nodes on the path to the account, starting with the root. If the account doesn't exist, the proof
+// proves its absence.
+func (state *DB) GetProof(addr types.Address) ([][]byte, error) {
+	var proof proofList
+	if err := state.globalTrie.Prove(crypto.Keccak256(addr[:]), 0, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// proofList collects the nodes of a proof in the order they're written by Trie.Prove
+type proofList [][]byte
+
+func (n *proofList) Put(key []byte, value []byte) error {
+	*n = append(*n, value)
+	return nil
+}
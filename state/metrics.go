@@ -0,0 +1,27 @@
+package state
+
+import (
+	"github.com/go-kit/kit/metrics"
+	prmkit "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "spacemesh"
+	subsystem = "mempool"
+)
+
+func newCounter(name, help string, labels []string) metrics.Counter {
+	return prmkit.NewCounterFrom(prometheus.CounterOpts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help}, labels)
+}
+
+// Reasons for evicting a transaction from the mempool, used as values for the "reason" label
+const (
+	evictReasonPoolFull     = "pool_full"
+	evictReasonAccountQuota = "account_quota"
+)
+
+var (
+	evictedTxs  = newCounter("evicted_txs", "number of transactions evicted from the mempool", []string{"reason"})
+	replacedTxs = newCounter("replaced_txs", "number of pending transactions replaced by a higher fee transaction", []string{})
+)
@@ -1,9 +1,13 @@
 package state
 
 import (
+	"bytes"
 	"container/list"
+	"errors"
 	"fmt"
 	"math/big"
+	"math/bits"
+	"sort"
 	"sync"
 
 	"github.com/spacemeshos/ed25519"
@@ -43,6 +47,7 @@ type TransactionProcessor struct {
 	*DB
 	pool         *TxMempool
 	processorDb  database.Database
+	diskDb       database.Database
 	currentLayer types.LayerID
 	rootHash     types.Hash32
 	stateQueue   list.List
@@ -50,9 +55,36 @@ type TransactionProcessor struct {
 	trie         *trie.Database
 	mu           sync.Mutex
 	rootMu       sync.RWMutex
+
+	// StateHistory is the number of latest layers whose state tries are kept, so their state can be loaded and queried.
+	// The tries of older layers are garbage collected from memory without being persisted, and from the disk at each
+	// checkpoint. If it's 0, the state of every layer is persisted and kept.
+	StateHistory uint32
+	// StateCheckpointInterval is the number of layers between the checkpoint layers, whose state tries are persisted
+	// when StateHistory is set, so the state can be recovered after a crash.
+	StateCheckpointInterval uint32
+	// StateCacheLimit is the memory the trie nodes of the kept states may take, or 0 for no limit. Past it, the oldest
+	// nodes are persisted, after which they're no longer garbage collected.
+	StateCacheLimit types.StorageSize
+	history         []layerRoot // the layers whose state tries are referenced in memory, oldest first
+	historyMu       sync.Mutex
+	// the roots of the persisted subtries that lost a reference since the last checkpoint, pruned at the next one
+	dereferenced []types.Hash32
+	// the receipts of the txs of the layer being applied, and their origins. They're written along with the applied
+	// layers of the txs in the same batch as the layer's state root, so they aren't recorded if the layer fails to apply.
+	receipts []types.Receipt
+	origins  []types.Address
+}
+
+type layerRoot struct {
+	layer types.LayerID
+	root  types.Hash32
 }
 
-const newRootKey = "root"
+const (
+	newRootKey       = "root"
+	receiptKeyPrefix = "receipt_"
+)
 
 // NewTransactionProcessor returns a new state processor
 func NewTransactionProcessor(allStates, processorDb database.Database, projector Projector, txPool *TxMempool, logger log.Log) *TransactionProcessor {
@@ -66,6 +98,7 @@ func NewTransactionProcessor(allStates, processorDb database.Database, projector
 		Log:          logger,
 		DB:           stateDb,
 		processorDb:  processorDb,
+		diskDb:       allStates,
 		currentLayer: 0,
 		rootHash:     root,
 		stateQueue:   list.List{},
@@ -99,42 +132,157 @@ func (tp *TransactionProcessor) GetLayerApplied(txID types.TransactionID) *types
 	return &layerID
 }
 
+func getReceiptKey(txID types.TransactionID) []byte {
+	return append([]byte(receiptKeyPrefix), txID.Bytes()...)
+}
+
+// GetReceipt returns the receipt recorded when the tx was applied, or when it failed to apply
+func (tp *TransactionProcessor) GetReceipt(txID types.TransactionID) (*types.Receipt, error) {
+	bts, err := tp.processorDb.Get(getReceiptKey(txID))
+	if err != nil {
+		return nil, err
+	}
+	var receipt types.Receipt
+	if err := types.BytesToInterface(bts, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode receipt of tx %v: %v", txID.ShortString(), err)
+	}
+	return &receipt, nil
+}
+
+// addReceipt records the receipt of a tx of the layer being applied, it's written with the layer's state root
+func (tp *TransactionProcessor) addReceipt(receipt types.Receipt, origin types.Address) {
+	tp.receipts = append(tp.receipts, receipt)
+	tp.origins = append(tp.origins, origin)
+}
+
+// writeReceipts adds the receipts of the txs of the layer being applied, and the layer of those that were applied, to
+// the batch.
+func (tp *TransactionProcessor) writeReceipts(batch database.Batch) error {
+	for _, receipt := range tp.receipts {
+		bts, err := types.InterfaceToBytes(&receipt)
+		if err != nil {
+			return fmt.Errorf("failed to encode receipt: %v", err)
+		}
+		if err := batch.Put(getReceiptKey(receipt.TxID), bts); err != nil {
+			return fmt.Errorf("failed to write receipt: %v", err)
+		}
+		if receipt.Result != types.TxResultApplied {
+			continue
+		}
+		if err := batch.Put(receipt.TxID.Bytes(), receipt.Layer.Bytes()); err != nil {
+			return fmt.Errorf("failed to add to applied txs: %v", err)
+		}
+	}
+	return nil
+}
+
+// reportReceipts reports the receipts of the layer that was applied, and clears them
+func (tp *TransactionProcessor) reportReceipts() {
+	for i, receipt := range tp.receipts {
+		events.ReportReceipt(events.TxReceipt{
+			ID:      receipt.TxID,
+			Result:  int(receipt.Result),
+			GasUsed: receipt.GasUsed,
+			Fee:     receipt.Fee,
+			Layer:   receipt.Layer,
+			Address: tp.origins[i],
+		})
+	}
+	tp.clearReceipts()
+}
+
+func (tp *TransactionProcessor) clearReceipts() {
+	tp.receipts = nil
+	tp.origins = nil
+}
+
 // ValidateNonceAndBalance validates that the tx origin account has enough balance to apply the tx,
 // also, it checks that nonce in tx is correct, returns error otherwise
 func (tp *TransactionProcessor) ValidateNonceAndBalance(tx *types.Transaction) error {
+	_, err := tp.validateNonceAndBalance(tx)
+	return err
+}
+
+// validateNonceAndBalance validates the tx like ValidateNonceAndBalance. A tx that reuses the nonce of a tx pending in
+// the mempool is valid if it pays a high enough fee to replace it, in which case the replaced tx is returned.
+func (tp *TransactionProcessor) validateNonceAndBalance(tx *types.Transaction) (replaced *types.Transaction, err error) {
+	if gas := tx.IntrinsicGas(); tx.GasLimit < gas {
+		return nil, fmt.Errorf("gas limit too low! Intrinsic gas: %d, gas limit: %d", gas, tx.GasLimit)
+	}
+	cost, ok := tx.TotalCost()
+	if !ok {
+		return nil, fmt.Errorf("total cost overflows! Transfers: %d, gas price: %d", len(tx.Transfers()), tx.GasPrice())
+	}
+	amount, _ := tx.TotalAmount() // it doesn't overflow if the cost doesn't
+	tp.rootMu.RLock()
+	nextLayer := tp.currentLayer + 1
+	tp.rootMu.RUnlock()
+	if err := tp.authorizeSpend(tx, nextLayer); err != nil {
+		return nil, err
+	}
 	origin := tx.Origin()
 	nonce, balance, err := tp.projector.GetProjection(origin, tp.GetNonce(origin), tp.GetBalance(origin))
 	if err != nil {
-		return fmt.Errorf("failed to project state for account %v: %v", origin.Short(), err)
+		return nil, fmt.Errorf("failed to project state for account %v: %v", origin.Short(), err)
 	}
-	if tx.AccountNonce != nonce {
-		return fmt.Errorf("incorrect account nonce! Expected: %d, Actual: %d", nonce, tx.AccountNonce)
+	if tx.AccountNonce < nonce {
+		if replaced, err = tp.pool.ReplacedBy(tx); err != nil {
+			return nil, err
+		}
 	}
-	if (tx.Amount + tx.Fee) > balance { // TODO: Fee represents the absolute fee here, as a temporarily hack
-		return fmt.Errorf("insufficient balance! Available: %d, Attempting to spend: %d[amount]+%d[fee]=%d",
-			balance, tx.Amount, tx.Fee, tx.Amount+tx.Fee)
+	if replaced == nil {
+		if tx.AccountNonce != nonce {
+			return nil, fmt.Errorf("incorrect account nonce! Expected: %d, Actual: %d", nonce, tx.AccountNonce)
+		}
+	} else {
+		// the projection accounts for the replaced tx, which won't be applied
+		replacedCost, _ := replaced.TotalCost() // it was validated when added to the pool, so it doesn't overflow
+		balance += replacedCost
 	}
-	return nil
+	if cost > balance {
+		return nil, fmt.Errorf("insufficient balance! Available: %d, Attempting to spend: %d[amount]+%d[fee]=%d",
+			balance, amount, cost-amount, cost)
+	}
+	return replaced, nil
 }
 
 // ApplyTransactions receives a batch of transaction to apply on state. Returns the number of transaction that failed to apply.
+// It commits the state, including any rewards applied to it for the layer, and records it as the layer's state along
+// with the receipts of the txs.
 func (tp *TransactionProcessor) ApplyTransactions(layer types.LayerID, txs []*types.Transaction) (int, error) {
 	if len(txs) == 0 {
-		err := tp.addStateToHistory(layer, tp.GetStateRoot())
-		return 0, err
+		newHash, err := tp.Commit()
+		if err != nil {
+			return 0, fmt.Errorf("failed to commit global state: %v", err)
+		}
+		return 0, tp.addStateToHistory(layer, newHash)
 	}
 
 	tp.mu.Lock()
 	defer tp.mu.Unlock()
+	// the receipts of a layer that failed to apply are dropped
+	defer tp.clearReceipts()
 	remaining := txs
 	remainingCount := len(remaining)
+	var errs []error
 	for { // Loop until there's nothing left to process
-		remaining = tp.Process(remaining, layer)
+		remaining, errs = tp.process(remaining, layer)
 		if remainingCount == len(remaining) {
 			break
 		}
 		remainingCount = len(remaining)
 	}
+	for i, tx := range remaining {
+		if tp.GetLayerApplied(tx.ID()) != nil {
+			continue // a tx that was already applied in an earlier layer keeps its receipt
+		}
+		result := types.TxResultUnknown
+		var txErr *txError
+		if errors.As(errs[i], &txErr) {
+			result = txErr.result
+		}
+		tp.addReceipt(types.Receipt{TxID: tx.ID(), Result: result, Layer: layer, Reason: errs[i].Error()}, tx.Origin())
+	}
 
 	newHash, err := tp.Commit()
 
@@ -149,28 +297,226 @@ func (tp *TransactionProcessor) ApplyTransactions(layer types.LayerID, txs []*ty
 
 func (tp *TransactionProcessor) addStateToHistory(layer types.LayerID, newHash types.Hash32) error {
 	tp.trie.Reference(newHash, types.Hash32{})
-	err := tp.trie.Commit(newHash, false)
+	if tp.StateHistory == 0 || tp.isCheckpoint(layer) {
+		if err := tp.trie.Commit(newHash, false); err != nil {
+			return err
+		}
+	}
+	err := tp.addState(newHash, layer)
 	if err != nil {
 		return err
 	}
-	err = tp.addState(newHash, layer)
+	tp.Log.With().Info("new state root", layer, log.String("state_root", newHash.String()))
+	if tp.StateHistory == 0 {
+		return nil
+	}
+	return tp.collectStateGarbage(layer, newHash)
+}
+
+func (tp *TransactionProcessor) isCheckpoint(layer types.LayerID) bool {
+	return tp.StateCheckpointInterval != 0 && uint32(layer)%tp.StateCheckpointInterval == 0
+}
+
+// collectStateGarbage keeps the state trie of the layer referenced in memory, and dereferences the tries of the layers
+// that fell out of the state history, which garbage collects their nodes that no kept trie uses. If the kept nodes take
+// more memory than the limit, the oldest are persisted. When the layer is a checkpoint, the persisted nodes that no
+// kept trie uses are deleted.
+func (tp *TransactionProcessor) collectStateGarbage(layer types.LayerID, root types.Hash32) error {
+	tp.historyMu.Lock()
+	defer tp.historyMu.Unlock()
+	tp.history = append(tp.history, layerRoot{layer: layer, root: root})
+	for len(tp.history) > int(tp.StateHistory) {
+		// the tries of checkpoint layers were already persisted and aren't in memory, so this is a no-op for them
+		tp.trie.Dereference(tp.history[0].root)
+		tp.history = tp.history[1:]
+	}
+	if nodes, _ := tp.trie.Size(); tp.StateCacheLimit != 0 && nodes > tp.StateCacheLimit {
+		if err := tp.trie.Cap(tp.StateCacheLimit); err != nil {
+			return fmt.Errorf("failed to persist state trie nodes: %v", err)
+		}
+	}
+	// without checkpoints, the persisted nodes are never pruned
+	dereferenced := tp.trie.TakeDereferenced()
+	if tp.StateCheckpointInterval != 0 {
+		tp.dereferenced = append(tp.dereferenced, dereferenced...)
+	}
+	if tp.isCheckpoint(layer) {
+		return tp.pruneCheckpoints(layer)
+	}
+	return nil
+}
+
+// pruneCheckpoints deletes the persisted state trie nodes that are only used by the tries of the layers outside the
+// state history window, which ends at the given checkpoint layer. This deletes the tries of older checkpoints, which
+// aren't needed to recover anymore, and the nodes that Cap persisted for the tries that were dereferenced since. Only
+// the persisted subtries that lost a reference since the last checkpoint are walked, along with the tries of the layers
+// that left the window since then, which covers the checkpoint the state was loaded from after a restart.
+func (tp *TransactionProcessor) pruneCheckpoints(checkpoint types.LayerID) error {
+	kept := make(map[types.Hash32]struct{})
+	first := windowStart(checkpoint, tp.StateHistory)
+	for l := first; l <= checkpoint; l++ {
+		root, err := tp.GetLayerStateRoot(l)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// the tries of the layers in the window that weren't kept, e.g. before a restart, are partly or entirely gone
+		if err := tp.markTrieNodes(root, kept); err != nil {
+			if _, ok := err.(*trie.MissingNodeError); !ok {
+				return fmt.Errorf("failed to walk state trie of layer %v: %v", l, err)
+			}
+		}
+	}
+	candidates := tp.dereferenced
+	if checkpoint >= types.LayerID(tp.StateCheckpointInterval) {
+		for l := windowStart(checkpoint-types.LayerID(tp.StateCheckpointInterval), tp.StateHistory); l < first; l++ {
+			root, err := tp.GetLayerStateRoot(l)
+			if err == database.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			candidates = append(candidates, root)
+		}
+	}
+	// the nodes already in the set are either kept or deleted
+	pruned := make(map[types.Hash32]struct{})
+	for hash := range kept {
+		pruned[hash] = struct{}{}
+	}
+	for _, root := range candidates {
+		// most of the tries of the layers that left the window were never persisted, or only partly
+		if err := tp.markTrieNodes(root, pruned); err != nil {
+			if _, ok := err.(*trie.MissingNodeError); !ok {
+				return fmt.Errorf("failed to walk state trie nodes of %v: %v", root.ShortString(), err)
+			}
+		}
+	}
+	batch := tp.diskDb.NewBatch()
+	for hash := range pruned {
+		if _, ok := kept[hash]; ok {
+			continue
+		}
+		if err := batch.Delete(hash.Bytes()); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to delete state trie nodes: %v", err)
+	}
+	tp.dereferenced = nil
+	tp.With().Info("pruned state trie nodes outside the state history", checkpoint,
+		log.Int("pruned_nodes", len(pruned)-len(kept)), log.Int("kept_nodes", len(kept)))
+	return nil
+}
+
+// windowStart returns the first layer of the state history window that ends at the layer.
+func windowStart(layer types.LayerID, history uint32) types.LayerID {
+	if uint64(layer) < uint64(history) {
+		return 0
+	}
+	return layer - types.LayerID(history) + 1
+}
+
+// markTrieNodes adds the hashes of the nodes of the state trie with the given root to the set. Subtries whose root is
+// already in the set aren't walked again.
+func (tp *TransactionProcessor) markTrieNodes(root types.Hash32, nodes map[types.Hash32]struct{}) error {
+	t, err := trie.New(root, tp.trie)
 	if err != nil {
 		return err
 	}
-	tp.Log.With().Info("new state root", layer, log.String("state_root", newHash.String()))
+	it := t.NodeIterator(nil)
+	descend := true
+	for it.Next(descend) {
+		hash := it.Hash()
+		if hash == (types.Hash32{}) {
+			// an embedded node, which is stored with its parent
+			descend = true
+			continue
+		}
+		_, seen := nodes[hash]
+		nodes[hash] = struct{}{}
+		descend = !seen
+	}
+	return it.Error()
+}
+
+// dropStateHistory dereferences the state tries of the layers after the given layer, once the state was reverted to it.
+func (tp *TransactionProcessor) dropStateHistory(layer types.LayerID) {
+	tp.historyMu.Lock()
+	defer tp.historyMu.Unlock()
+	for len(tp.history) > 0 && tp.history[len(tp.history)-1].layer > layer {
+		tp.trie.Dereference(tp.history[len(tp.history)-1].root)
+		tp.history = tp.history[:len(tp.history)-1]
+	}
+}
+
+// RevertLayers deletes what was recorded when the layers after the given layer, up to and including latest, were
+// applied: their state roots, and the receipts and applied layers of the given transactions that were applied in them.
+// It's used once the state was reverted to the layer, so the layers after it can be applied again.
+func (tp *TransactionProcessor) RevertLayers(layer, latest types.LayerID, txIDs []types.TransactionID) error {
+	batch := tp.processorDb.NewBatch()
+	for l := layer + 1; l <= latest; l++ {
+		if err := batch.Delete(getStateRootLayerKey(l)); err != nil {
+			return err
+		}
+	}
+	for _, id := range txIDs {
+		if applied := tp.GetLayerApplied(id); applied != nil && *applied > layer {
+			if err := batch.Delete(id.Bytes()); err != nil {
+				return err
+			}
+		}
+		receipt, err := tp.GetReceipt(id)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if receipt.Layer > layer {
+			if err := batch.Delete(getReceiptKey(id)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to revert layers after %v: %v", layer, err)
+	}
 	return nil
 }
 
+// PersistState persists the state trie of the latest layer in state, so it can be loaded after a restart without
+// applying again the layers after the latest checkpoint. It's only needed when StateHistory is set.
+func (tp *TransactionProcessor) PersistState() error {
+	if tp.StateHistory == 0 {
+		return nil
+	}
+	return tp.trie.Commit(tp.GetStateRoot(), true)
+}
+
 func getStateRootLayerKey(layer types.LayerID) []byte {
 	return append([]byte(newRootKey), layer.Bytes()...)
 }
 
 func (tp *TransactionProcessor) addState(stateRoot types.Hash32, layer types.LayerID) error {
-	if err := tp.processorDb.Put(getStateRootLayerKey(layer), stateRoot.Bytes()); err != nil {
+	batch := tp.processorDb.NewBatch()
+	if err := batch.Put(getStateRootLayerKey(layer), stateRoot.Bytes()); err != nil {
+		return err
+	}
+	if err := tp.writeReceipts(batch); err != nil {
 		return err
 	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	tp.reportReceipts()
 	tp.rootMu.Lock()
 	tp.rootHash = stateRoot
+	tp.currentLayer = layer
 	tp.rootMu.Unlock()
 	return nil
 }
@@ -186,10 +532,134 @@ func (tp *TransactionProcessor) GetLayerStateRoot(layer types.LayerID) (types.Ha
 	return x, nil
 }
 
+// openLayerState returns the state as it was after the given layer was applied, and its root.
+func (tp *TransactionProcessor) openLayerState(layer types.LayerID) (*DB, types.Hash32, error) {
+	root, err := tp.GetLayerStateRoot(layer)
+	if err != nil {
+		return nil, types.Hash32{}, err
+	}
+	layerState, err := New(root, tp.db)
+	if _, ok := err.(*trie.MissingNodeError); ok {
+		return nil, types.Hash32{}, fmt.Errorf("state at layer %v is no longer kept: %v", layer, err)
+	}
+	if err != nil {
+		return nil, types.Hash32{}, fmt.Errorf("failed to open state at layer %v: %v", layer, err)
+	}
+	return layerState, root, nil
+}
+
+// VerifyStateRoots checks that a state root was recorded for every layer between the first and the last layer that
+// have one, and that every node of the state tries that should be persisted can be read: those of all layers, or with
+// StateHistory only that of the latest checkpoint layer, since older checkpoints are pruned. Subtries shared with a trie
+// that was already walked aren't walked again, so a missing node is only reported for the first layer that has it. All
+// the inconsistencies found are returned, and err is only set if the database couldn't be read.
+func (tp *TransactionProcessor) VerifyStateRoots() (problems []error, err error) {
+	roots := make(map[types.LayerID]types.Hash32)
+	var first, last types.LayerID
+	it := tp.processorDb.Find([]byte(newRootKey))
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		// the same db holds the layers in which txs were applied, keyed by tx ID
+		if len(it.Key()) != len(getStateRootLayerKey(0)) {
+			continue
+		}
+		layer := types.LayerID(util.BytesToUint64(it.Key()[len(newRootKey):]))
+		roots[layer] = types.BytesToHash(it.Value())
+		if len(roots) == 1 || layer < first {
+			first = layer
+		}
+		if layer > last {
+			last = layer
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read state roots: %v", err)
+	}
+	if len(roots) == 0 {
+		return nil, nil
+	}
+	walked := make(map[types.Hash32]struct{})
+	for layer := first; layer <= last; layer++ {
+		root, ok := roots[layer]
+		if !ok {
+			problems = append(problems, fmt.Errorf("layer %v has no state root", layer))
+			continue
+		}
+		if tp.StateHistory != 0 && !(tp.isCheckpoint(layer) && uint32(last-layer) < tp.StateCheckpointInterval) {
+			continue
+		}
+		if err := tp.markTrieNodes(root, walked); err != nil {
+			problems = append(problems, fmt.Errorf("state trie %v of layer %v can't be read: %v", root.ShortString(), layer, err))
+		}
+	}
+	return problems, nil
+}
+
+// accountIn returns the state of the account associated with addr in the given state, or an empty state if it doesn't
+// exist.
+func accountIn(st *DB, addr types.Address) (*types.AccountState, error) {
+	obj := st.getStateObj(addr)
+	if obj == nil {
+		return &types.AccountState{}, st.Error()
+	}
+	account := obj.account
+	return &account, nil
+}
+
+// GetAccountAtLayer returns the state of the account associated with addr as it was after the given layer was applied.
+// Accounts that didn't exist at that layer have an empty state. It returns an error if no state was recorded for the
+// layer.
+func (tp *TransactionProcessor) GetAccountAtLayer(addr types.Address, layer types.LayerID) (*types.AccountState, error) {
+	layerState, _, err := tp.openLayerState(layer)
+	if err != nil {
+		return nil, err
+	}
+	return accountIn(layerState, addr)
+}
+
+// GetAccountProof returns the state of the account associated with addr as it was after the given layer was applied,
+// along with a Merkle proof of it against the layer's state root.
+func (tp *TransactionProcessor) GetAccountProof(addr types.Address, layer types.LayerID) (*types.AccountProof, error) {
+	layerState, root, err := tp.openLayerState(layer)
+	if err != nil {
+		return nil, err
+	}
+	account, err := accountIn(layerState, addr)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := layerState.GetProof(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove account %v at layer %v: %v", addr.Short(), layer, err)
+	}
+	return &types.AccountProof{Layer: layer, Root: root, Account: *account, Nodes: nodes}, nil
+}
+
 // ApplyRewards applies reward reward to miners vector for layer
+// The rewards are applied to the current state without committing it, so ApplyTransactions commits them atomically
+// with the layer's transactions. If any of the rewards can't be applied, none are and an error is returned.
 // TODO: convert rewards to uint64 (see https://github.com/spacemeshos/go-spacemesh/issues/2069)
-func (tp *TransactionProcessor) ApplyRewards(layer types.LayerID, miners []types.Address, reward *big.Int) {
+func (tp *TransactionProcessor) ApplyRewards(layer types.LayerID, miners []types.Address, reward *big.Int) error {
+	if !reward.IsUint64() {
+		return fmt.Errorf("reward %v doesn't fit in 64 bits", reward)
+	}
 	rewardConverted := reward.Uint64()
+	totals := make(map[types.Address]uint64, len(miners))
+	for _, account := range miners {
+		total, carry := bits.Add64(totals[account], rewardConverted, 0)
+		if carry != 0 {
+			return fmt.Errorf("total reward of account %v overflows", account.Short())
+		}
+		totals[account] = total
+	}
+	for account, total := range totals {
+		if _, carry := bits.Add64(tp.GetBalance(account), total, 0); carry != 0 {
+			return fmt.Errorf("reward of %d overflows the balance of account %v", total, account.Short())
+		}
+	}
+
 	for _, account := range miners {
 		tp.Log.With().Info("reward applied",
 			log.String("account", account.Short()),
@@ -198,19 +668,12 @@ func (tp *TransactionProcessor) ApplyRewards(layer types.LayerID, miners []types
 		)
 		tp.AddBalance(account, rewardConverted)
 	}
-
-	newHash, err := tp.Commit()
-	if err != nil {
-		tp.With().Error("trie write error", log.Err(err))
-		return
-	}
-
-	if err = tp.addStateToHistory(layer, newHash); err != nil {
-		tp.With().Error("failed to add state to history", log.Err(err))
-	}
+	return nil
 }
 
 // LoadState loads the last state from persistent storage
+// It returns an error if the state trie of the layer isn't kept, e.g. because it was garbage collected or wasn't
+// persisted before a restart.
 func (tp *TransactionProcessor) LoadState(layer types.LayerID) error {
 	tp.mu.Lock()
 	defer tp.mu.Unlock()
@@ -220,8 +683,9 @@ func (tp *TransactionProcessor) LoadState(layer types.LayerID) error {
 	}
 	newState, err := New(state, tp.db)
 	if err != nil {
-		log.Panic("cannot revert- improper state: %v", err)
+		return fmt.Errorf("cannot load state of layer %v: %v", layer, err)
 	}
+	tp.dropStateHistory(layer)
 
 	tp.Log.Info("reverted, new root %x", newState.IntermediateRoot(false))
 	tp.Log.With().Info("reverted", log.String("root_hash", newState.IntermediateRoot(false).String()))
@@ -229,6 +693,7 @@ func (tp *TransactionProcessor) LoadState(layer types.LayerID) error {
 	tp.DB = newState
 	tp.rootMu.Lock()
 	tp.rootHash = state
+	tp.currentLayer = layer
 	tp.rootMu.Unlock()
 
 	return nil
@@ -236,11 +701,18 @@ func (tp *TransactionProcessor) LoadState(layer types.LayerID) error {
 
 // Process applies transaction vector to current state, it returns the remaining transactions that failed
 func (tp *TransactionProcessor) Process(txs []*types.Transaction, layerID types.LayerID) (remaining []*types.Transaction) {
+	remaining, _ = tp.process(txs, layerID)
+	return
+}
+
+// process is like Process, but also returns the error each remaining transaction failed with
+func (tp *TransactionProcessor) process(txs []*types.Transaction, layerID types.LayerID) (remaining []*types.Transaction, errs []error) {
 	for _, tx := range txs {
 		err := tp.ApplyTransaction(tx, layerID)
 		if err != nil {
 			tp.With().Warning("failed to apply transaction", tx.ID(), log.Err(err))
 			remaining = append(remaining, tx)
+			errs = append(errs, err)
 		}
 		events.ReportValidTx(tx, err == nil)
 		events.ReportNewTx(tx)
@@ -256,39 +728,105 @@ var (
 	errOrigin = "origin account doesnt exist"
 	errFunds  = "insufficient funds"
 	errNonce  = "incorrect nonce"
+	errGas    = "gas limit too low"
+	errAuth   = "unauthorized spend"
 )
 
+// authorizeSpend checks that tx may spend from its origin account in the given layer. Simple accounts are spent by
+// transfers, whose origin is the key that signed them. Multisig and vault accounts are only spent by spend transactions
+// signed by at least a threshold of their owners, and vaults only once they're unlocked.
+func (tp *TransactionProcessor) authorizeSpend(tx *types.Transaction, layer types.LayerID) error {
+	control := tp.GetAccountControl(tx.Origin())
+	if tx.Type != types.TxTypeSpend {
+		if control != nil {
+			return fmt.Errorf("account %v can only be spent by a spend transaction", tx.Origin().Short())
+		}
+		return nil
+	}
+	if control == nil {
+		return fmt.Errorf("account %v is not a multisig or vault account", tx.Origin().Short())
+	}
+	if layer < control.UnlockLayer {
+		return fmt.Errorf("account %v is locked until layer %v", tx.Origin().Short(), control.UnlockLayer)
+	}
+	signers, err := tx.Signers()
+	if err != nil {
+		return err
+	}
+	signed := make(map[types.Address]struct{}, len(signers))
+	for _, signer := range signers {
+		signed[signer] = struct{}{}
+	}
+	approvals := uint64(0)
+	for _, owner := range control.Owners {
+		if _, ok := signed[owner]; ok {
+			approvals++
+		}
+	}
+	if approvals < control.Threshold {
+		return fmt.Errorf("not enough owners signed! Required: %d, signed: %d", control.Threshold, approvals)
+	}
+	return nil
+}
+
+// txError is an error applying a transaction, along with the result recorded in its receipt
+type txError struct {
+	result types.TxResult
+	msg    string
+}
+
+func (e *txError) Error() string {
+	return e.msg
+}
+
 // ApplyTransaction applies provided transaction trans to the current state, but does not commit it to persistent
 // storage. it returns error if there is not enough balance in src account to perform the transaction and pay
-// fee or if the nonce is invalid
+// fee or if the nonce is invalid. Its receipt is recorded along with the state of the layer.
 func (tp *TransactionProcessor) ApplyTransaction(trans *types.Transaction, layerID types.LayerID) error {
 	if !tp.Exist(trans.Origin()) {
-		return fmt.Errorf(errOrigin)
+		return &txError{result: types.TxResultInsufficientFunds, msg: errOrigin}
+	}
+	if err := tp.authorizeSpend(trans, layerID); err != nil {
+		tp.Log.Error(errAuth+": %v", err)
+		return &txError{result: types.TxResultUnauthorized, msg: errAuth}
 	}
 
 	origin := tp.GetOrNewStateObj(trans.Origin())
 
-	amountWithFee := trans.Fee + trans.Amount
-
+	gasUsed := trans.IntrinsicGas()
+	if trans.GasLimit < gasUsed {
+		tp.Log.Error(errGas+" limit: %v intrinsic gas: %v", trans.GasLimit, gasUsed)
+		return &txError{result: types.TxResultInsufficientGas, msg: errGas}
+	}
+	amountWithFee, ok := trans.TotalCost()
+	amount, _ := trans.TotalAmount()
 	// todo: should we allow to spend all accounts balance?
-	if origin.Balance() <= amountWithFee {
-		tp.Log.Error(errFunds+" have: %v need: %v", origin.Balance(), amountWithFee)
-		return fmt.Errorf(errFunds)
+	if !ok || origin.Balance() <= amountWithFee {
+		tp.Log.Error(errFunds+" have: %v need: %v[amount]+%v[gas]*%v[gas price]",
+			origin.Balance(), amount, gasUsed, trans.GasPrice())
+		return &txError{result: types.TxResultInsufficientFunds, msg: errFunds}
 	}
 
 	if !tp.checkNonce(trans) {
 		tp.Log.Error(errNonce+" should be %v actual %v", tp.GetNonce(trans.Origin()), trans.AccountNonce)
-		return fmt.Errorf(errNonce)
+		return &txError{result: types.TxResultBadNonce, msg: errNonce}
 	}
 
 	tp.SetNonce(trans.Origin(), tp.GetNonce(trans.Origin())+1) // TODO: Not thread-safe
-	transfer(tp, trans.Origin(), trans.Recipient, trans.Amount)
+	if trans.Type == types.TxTypeCreateAccount {
+		tp.SetAccountControl(trans.CreatedAccount(), *trans.Control)
+	}
+	// the origin can pay for all the transfers of a batch, so they're either all made or, if any check above failed,
+	// none of them are
+	for _, t := range trans.Transfers() {
+		transfer(tp, trans.Origin(), t.Recipient, t.Amount)
+	}
 
 	// subtract fee from account, fee will be sent to miners in layers after
-	tp.SubBalance(trans.Origin(), trans.Fee)
-	if err := tp.processorDb.Put(trans.ID().Bytes(), layerID.Bytes()); err != nil {
-		return fmt.Errorf("failed to add to applied txs: %v", err)
-	}
+	fee := amountWithFee - amount
+	tp.SubBalance(trans.Origin(), fee)
+	tp.addReceipt(types.Receipt{TxID: trans.ID(), Result: types.TxResultApplied, GasUsed: gasUsed, Fee: fee, Layer: layerID},
+		trans.Origin())
 	tp.With().Info("transaction processed", log.String("transaction", trans.String()))
 	return nil
 }
@@ -321,7 +859,8 @@ func (tp *TransactionProcessor) HandleTxData(data service.GossipMessage, syncer
 			tx.ID(), log.String("origin", tx.Origin().Short()), log.Err(err))
 		return
 	}
-	if err := tp.ValidateNonceAndBalance(tx); err != nil {
+	replaced, err := tp.validateNonceAndBalance(tx)
+	if err != nil {
 		tp.With().Error("nonce and balance validation failed", tx.ID(), log.Err(err))
 		return
 	}
@@ -333,17 +872,85 @@ func (tp *TransactionProcessor) HandleTxData(data service.GossipMessage, syncer
 		log.Uint64("gas", tx.GasLimit),
 		log.String("recipient", tx.Recipient.String()),
 		log.String("origin", tx.Origin().String()))
+	if err := tp.addTxToPool(tx, replaced); err != nil {
+		tp.With().Error("failed to replace pending tx", tx.ID(), log.Err(err))
+		return
+	}
 	data.ReportValidation(IncomingTxProtocol)
-	tp.pool.Put(tx.ID(), tx)
+}
+
+// RestoreTxPool reloads the transactions journaled by the mempool before the node was restarted. Each transaction is
+// revalidated against the current state and projection, and the ones that are no longer valid are discarded.
+func (tp *TransactionProcessor) RestoreTxPool() error {
+	journaled, err := tp.pool.ReadJournal()
+	if err != nil {
+		return err
+	}
+	txs := make([]*types.Transaction, 0, len(journaled))
+	for _, tx := range journaled {
+		if err := tx.CalcAndSetOrigin(); err != nil {
+			tp.With().Info("discarding journaled tx, failed to calc its origin", tx.ID(), log.Err(err))
+			tp.pool.DiscardJournaled(tx.ID())
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	// Re-add each account's txs in nonce order, so each one is validated against the projection of the ones before it.
+	// If there are several versions of the same nonce, the one with the highest fee is kept.
+	sort.Slice(txs, func(i, j int) bool {
+		oi, oj := txs[i].Origin(), txs[j].Origin()
+		if cmp := bytes.Compare(oi[:], oj[:]); cmp != 0 {
+			return cmp < 0
+		}
+		if txs[i].AccountNonce != txs[j].AccountNonce {
+			return txs[i].AccountNonce < txs[j].AccountNonce
+		}
+		return txs[i].Fee > txs[j].Fee
+	})
+
+	restored := 0
+	for _, tx := range txs {
+		if !tp.AddressExists(tx.Origin()) {
+			tp.With().Info("discarding journaled tx, origin does not exist", tx.ID())
+			tp.pool.DiscardJournaled(tx.ID())
+			continue
+		}
+		if err := tp.ValidateAndAddTxToPool(tx); err != nil {
+			tp.With().Info("discarding journaled tx", tx.ID(), log.Err(err))
+			tp.pool.DiscardJournaled(tx.ID())
+			continue
+		}
+		restored++
+	}
+	tp.With().Info("restored mempool from journal",
+		log.Int("restored", restored),
+		log.Int("discarded", len(journaled)-restored))
+	return nil
 }
 
 // ValidateAndAddTxToPool validates the provided tx nonce and balance with projector and puts it in the transaction pool
 // it returns an error if the provided tx is not valid
 func (tp *TransactionProcessor) ValidateAndAddTxToPool(tx *types.Transaction) error {
-	err := tp.ValidateNonceAndBalance(tx)
+	replaced, err := tp.validateNonceAndBalance(tx)
 	if err != nil {
 		return err
 	}
-	tp.pool.Put(tx.ID(), tx)
+	return tp.addTxToPool(tx, replaced)
+}
+
+func (tp *TransactionProcessor) addTxToPool(tx, replaced *types.Transaction) error {
+	if replaced == nil {
+		tp.pool.Put(tx.ID(), tx)
+		return nil
+	}
+	if err := tp.pool.Replace(tx.ID(), tx, replaced); err != nil {
+		return err
+	}
+	tp.With().Info("replaced pending tx",
+		tx.ID(),
+		log.String("replaced_tx", replaced.ID().ShortString()),
+		log.Uint64("nonce", tx.AccountNonce),
+		log.Uint64("fee", tx.Fee),
+		log.Uint64("replaced_fee", replaced.Fee))
 	return nil
 }
@@ -1,7 +1,11 @@
 package state
 
 import (
+	"bytes"
 	crand "crypto/rand"
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -10,6 +14,7 @@ import (
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/pendingtxs"
 	"github.com/spacemeshos/go-spacemesh/signing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,12 +41,20 @@ type appliedTxsMock struct{}
 
 func (appliedTxsMock) Put(key []byte, value []byte) error { return nil }
 func (appliedTxsMock) Delete(key []byte) error            { panic("implement me") }
-func (appliedTxsMock) Get(key []byte) ([]byte, error)     { panic("implement me") }
+func (appliedTxsMock) Get(key []byte) ([]byte, error)     { return nil, database.ErrNotFound }
 func (appliedTxsMock) Has(key []byte) (bool, error)       { panic("implement me") }
 func (appliedTxsMock) Close()                             { panic("implement me") }
-func (appliedTxsMock) NewBatch() database.Batch           { panic("implement me") }
+func (appliedTxsMock) NewBatch() database.Batch           { return appliedTxsBatchMock{} }
 func (appliedTxsMock) Find(key []byte) database.Iterator  { panic("implement me") }
 
+type appliedTxsBatchMock struct{}
+
+func (appliedTxsBatchMock) Put(key []byte, value []byte) error { return nil }
+func (appliedTxsBatchMock) Delete(key []byte) error            { panic("implement me") }
+func (appliedTxsBatchMock) ValueSize() int                     { panic("implement me") }
+func (appliedTxsBatchMock) Write() error                       { return nil }
+func (appliedTxsBatchMock) Reset()                             { panic("implement me") }
+
 func (s *ProcessorStateSuite) SetupTest() {
 	lg := log.NewDefault("proc_logger")
 	s.db = database.NewMemDatabase()
@@ -198,7 +211,7 @@ func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_Errors()
 }
 
 func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyRewards() {
-	s.processor.ApplyRewards(1, []types.Address{types.HexToAddress("aaa"),
+	err := s.processor.ApplyRewards(1, []types.Address{types.HexToAddress("aaa"),
 		types.HexToAddress("bbb"),
 		types.HexToAddress("ccc"),
 		types.HexToAddress("ddd"),
@@ -206,6 +219,7 @@ func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyRewards() {
 		types.HexToAddress("aaa")},
 		big.NewInt(int64(1000)),
 	)
+	assert.NoError(s.T(), err)
 
 	assert.Equal(s.T(), s.processor.GetBalance(types.HexToAddress("aaa")), uint64(2000))
 	assert.Equal(s.T(), s.processor.GetBalance(types.HexToAddress("bbb")), uint64(2000))
@@ -213,6 +227,28 @@ func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyRewards() {
 	assert.Equal(s.T(), s.processor.GetBalance(types.HexToAddress("ddd")), uint64(1000))
 }
 
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyRewards_Overflow() {
+	createAccount(s.processor, types.HexToAddress("aaa"), 0, 0)
+	s.processor.SetBalance(types.HexToAddress("aaa"), math.MaxUint64-1000)
+	s.processor.Commit()
+
+	// the second reward of aaa overflows its balance, so none of the rewards are applied
+	err := s.processor.ApplyRewards(1, []types.Address{types.HexToAddress("bbb"),
+		types.HexToAddress("aaa"),
+		types.HexToAddress("aaa")},
+		big.NewInt(int64(1000)),
+	)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), uint64(math.MaxUint64-1000), s.processor.GetBalance(types.HexToAddress("aaa")))
+	assert.Equal(s.T(), uint64(0), s.processor.GetBalance(types.HexToAddress("bbb")))
+
+	err = s.processor.ApplyRewards(1, []types.Address{types.HexToAddress("bbb")},
+		new(big.Int).Lsh(big.NewInt(1), 64),
+	)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), uint64(0), s.processor.GetBalance(types.HexToAddress("bbb")))
+}
+
 func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_OrderByNonce() {
 	signerBuf := []byte("22222222222222222222222222222222")
 	signerBuf = append(signerBuf, []byte{
@@ -484,10 +520,543 @@ func (s *ProcessorStateSuite) TestTransactionProcessor_ValidateNonceAndBalance_I
 	r.EqualError(err, "insufficient balance! Available: 90, Attempting to spend: 94[amount]+1[fee]=95")
 }
 
+func (s *ProcessorStateSuite) TestTransactionProcessor_ValidateAndAddTxToPool_GasLimitTooLow() {
+	r := require.New(s.T())
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+	s.processor.SetBalance(origin, 100)
+	s.processor.SetNonce(origin, 5)
+
+	tx, err := types.NewSignedTx(5, types.Address{1}, 10, types.GasTransfer-1, 1, signer)
+	r.NoError(err)
+	r.EqualError(s.processor.ValidateAndAddTxToPool(tx), "gas limit too low! Intrinsic gas: 1, gas limit: 0")
+	_, err = s.processor.pool.Get(tx.ID())
+	r.Error(err)
+
+	err = s.processor.ApplyTransaction(tx, 0)
+	r.EqualError(err, errGas)
+	r.Equal(uint64(100), s.processor.GetBalance(origin))
+	r.Equal(uint64(5), s.processor.GetNonce(origin))
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_GasFee() {
+	r := require.New(s.T())
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+	s.processor.SetBalance(origin, 100)
+
+	// the fee is the gas used times the gas price, regardless of the gas limit
+	tx, err := types.NewSignedTx(0, types.Address{1}, 10, 1000, 3, signer)
+	r.NoError(err)
+	r.NoError(s.processor.ApplyTransaction(tx, 1))
+	r.Equal(uint64(100-10-3*types.GasTransfer), s.processor.GetBalance(origin))
+	r.Equal(uint64(10), s.processor.GetBalance(types.Address{1}))
+
+	// a gas price that overflows the cost can't be paid
+	tx, err = types.NewSignedTx(1, types.Address{1}, 10, 1000, ^uint64(0), signer)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 1), errFunds)
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_Batch() {
+	r := require.New(s.T())
+	signer := signing.NewEdSigner()
+	origin := SignerToAddr(signer)
+	s.processor.SetBalance(origin, 100)
+	transfers := []types.Transfer{
+		{Recipient: types.Address{1}, Amount: 10},
+		{Recipient: types.Address{2}, Amount: 20},
+		{Recipient: types.Address{1}, Amount: 30},
+	}
+
+	// the fee covers the gas of every transfer
+	tx, err := types.NewSignedBatchTx(0, transfers, 2, 1, signer)
+	r.NoError(err)
+	r.EqualError(s.processor.ValidateNonceAndBalance(tx), "gas limit too low! Intrinsic gas: 3, gas limit: 2")
+
+	// a batch the origin can't pay in full makes none of its transfers
+	transfers[1].Amount = 60
+	tx, err = types.NewSignedBatchTx(0, transfers, 100, 1, signer)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 1), errFunds)
+	r.Equal(uint64(100), s.processor.GetBalance(origin))
+	r.Equal(uint64(0), s.processor.GetBalance(types.Address{1}))
+	r.Equal(uint64(0), s.processor.GetNonce(origin))
+
+	transfers[1].Amount = 20
+	tx, err = types.NewSignedBatchTx(0, transfers, 100, 1, signer)
+	r.NoError(err)
+	r.NoError(s.processor.ValidateNonceAndBalance(tx))
+	r.NoError(s.processor.ApplyTransaction(tx, 1))
+	r.Equal(uint64(100-60-3*types.GasTransfer), s.processor.GetBalance(origin))
+	r.Equal(uint64(40), s.processor.GetBalance(types.Address{1}))
+	r.Equal(uint64(20), s.processor.GetBalance(types.Address{2}))
+	r.Equal(uint64(1), s.processor.GetNonce(origin))
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_Multisig() {
+	r := require.New(s.T())
+	owner1, owner2, owner3 := signing.NewEdSigner(), signing.NewEdSigner(), signing.NewEdSigner()
+	account := types.Address{0xaa}
+	s.processor.SetBalance(account, 100)
+	s.processor.SetAccountControl(account, types.AccountControl{
+		Threshold: 2,
+		Owners:    []types.Address{SignerToAddr(owner1), SignerToAddr(owner2), SignerToAddr(owner3)},
+	})
+
+	// a single owner can't spend, nor can an owner signing twice
+	tx, err := types.NewSignedSpendTx(0, account, types.Address{1}, 10, 100, 1, owner1)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 1), errAuth)
+	tx, err = types.NewSignedSpendTx(0, account, types.Address{1}, 10, 100, 1, owner1, owner1)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 1), errAuth)
+	// signers that aren't owners don't count
+	tx, err = types.NewSignedSpendTx(0, account, types.Address{1}, 10, 100, 1, owner1, signing.NewEdSigner())
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 1), errAuth)
+	r.Equal(uint64(100), s.processor.GetBalance(account))
+
+	tx, err = types.NewSignedSpendTx(0, account, types.Address{1}, 10, 100, 1, owner3, owner1)
+	r.NoError(err)
+	r.NoError(s.processor.ApplyTransaction(tx, 1))
+	r.Equal(uint64(100-10-tx.IntrinsicGas()), s.processor.GetBalance(account))
+	r.Equal(uint64(1), s.processor.GetNonce(account))
+	r.Equal(uint64(10), s.processor.GetBalance(types.Address{1}))
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_Vault() {
+	r := require.New(s.T())
+	owner := signing.NewEdSigner()
+	vault := types.Address{0xbb}
+	s.processor.SetBalance(vault, 100)
+	s.processor.SetAccountControl(vault, types.AccountControl{
+		Threshold:   1,
+		Owners:      []types.Address{SignerToAddr(owner)},
+		UnlockLayer: 5,
+	})
+
+	tx, err := types.NewSignedSpendTx(0, vault, types.Address{1}, 10, 100, 1, owner)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(tx, 4), errAuth)
+	r.Equal(uint64(100), s.processor.GetBalance(vault))
+
+	r.NoError(s.processor.ApplyTransaction(tx, 5))
+	r.Equal(uint64(100-10-tx.IntrinsicGas()), s.processor.GetBalance(vault))
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ApplyTransaction_CreateAccount() {
+	r := require.New(s.T())
+	creator, owner := signing.NewEdSigner(), signing.NewEdSigner()
+	s.processor.SetBalance(SignerToAddr(creator), 100)
+	control := types.AccountControl{Threshold: 1, Owners: []types.Address{SignerToAddr(owner)}, UnlockLayer: 3}
+
+	tx, err := types.NewSignedCreateAccountTx(0, control, 40, 100, 1, creator)
+	r.NoError(err)
+	r.NoError(s.processor.ApplyTransaction(tx, 1))
+	account := tx.CreatedAccount()
+	r.Equal(uint64(100-40-tx.IntrinsicGas()), s.processor.GetBalance(SignerToAddr(creator)))
+	r.Equal(uint64(40), s.processor.GetBalance(account))
+	r.Equal(&control, s.processor.GetAccountControl(account))
+
+	// the created account is spent like any vault
+	spend, err := types.NewSignedSpendTx(0, account, types.Address{1}, 10, 100, 1, owner)
+	r.NoError(err)
+	r.EqualError(s.processor.ApplyTransaction(spend, 2), errAuth)
+	r.NoError(s.processor.ApplyTransaction(spend, 3))
+	r.Equal(uint64(10), s.processor.GetBalance(types.Address{1}))
+}
+
+func (s *ProcessorStateSuite) TestTransactionProcessor_ValidateNonceAndBalance_Spend() {
+	r := require.New(s.T())
+	owner := signing.NewEdSigner()
+	ownerAddr := SignerToAddr(owner)
+	s.processor.SetBalance(ownerAddr, 100)
+	vault := types.Address{0xcc}
+	s.processor.SetBalance(vault, 100)
+	s.processor.SetAccountControl(vault, types.AccountControl{
+		Threshold:   1,
+		Owners:      []types.Address{ownerAddr},
+		UnlockLayer: 5,
+	})
+
+	// the vault is still locked for the next layer
+	tx, err := types.NewSignedSpendTx(0, vault, types.Address{1}, 10, 100, 1, owner)
+	r.NoError(err)
+	r.EqualError(s.processor.ValidateNonceAndBalance(tx), fmt.Sprintf("account %v is locked until layer 5", vault.Short()))
+
+	// simple accounts can't be spent by a spend transaction, nor controlled accounts by a transfer
+	tx, err = types.NewSignedSpendTx(0, ownerAddr, types.Address{1}, 10, 100, 1, owner)
+	r.NoError(err)
+	r.EqualError(s.processor.ValidateNonceAndBalance(tx), fmt.Sprintf("account %v is not a multisig or vault account", ownerAddr.Short()))
+	s.processor.SetAccountControl(ownerAddr, types.AccountControl{Threshold: 1, Owners: []types.Address{ownerAddr}})
+	tx = createTransaction(s.T(), 0, types.Address{1}, 10, 1, owner)
+	r.EqualError(s.processor.ValidateNonceAndBalance(tx), fmt.Sprintf("account %v can only be spent by a spend transaction", ownerAddr.Short()))
+}
+
+type failingBatchDB struct {
+	database.Database
+	fail bool
+}
+
+func (db *failingBatchDB) NewBatch() database.Batch {
+	return &failingBatch{Batch: db.Database.NewBatch(), db: db}
+}
+
+type failingBatch struct {
+	database.Batch
+	db *failingBatchDB
+}
+
+func (b *failingBatch) Write() error {
+	if b.db.fail {
+		return errors.New("write failed")
+	}
+	return b.Batch.Write()
+}
+
+func TestTransactionProcessor_ApplyTransactions_FailedLayer(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	processorDb := &failingBatchDB{Database: database.NewMemDatabase()}
+	proc := NewTransactionProcessor(database.NewMemDatabase(), processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+	createAccount(proc, origin, 1000, 0)
+	_, err := proc.ApplyTransactions(1, nil)
+	r.NoError(err)
+
+	// nothing is recorded for the txs of a layer whose state fails to be recorded
+	applied := createTransaction(t, 0, types.Address{1}, 10, 1, signer)
+	badNonce := createTransaction(t, 5, types.Address{1}, 10, 1, signer)
+	processorDb.fail = true
+	_, err = proc.ApplyTransactions(2, []*types.Transaction{applied, badNonce})
+	r.Error(err)
+	for _, tx := range []*types.Transaction{applied, badNonce} {
+		r.Nil(proc.GetLayerApplied(tx.ID()))
+		_, err = proc.GetReceipt(tx.ID())
+		r.Equal(database.ErrNotFound, err)
+	}
+
+	// the layer is applied again
+	processorDb.fail = false
+	r.NoError(proc.LoadState(1))
+	_, err = proc.ApplyTransactions(2, []*types.Transaction{applied, badNonce})
+	r.NoError(err)
+	r.Equal(types.LayerID(2), *proc.GetLayerApplied(applied.ID()))
+	r.Nil(proc.GetLayerApplied(badNonce.ID()))
+	receipt, err := proc.GetReceipt(badNonce.ID())
+	r.NoError(err)
+	r.Equal(types.TxResultBadNonce, receipt.Result)
+}
+
+func TestTransactionProcessor_ApplyTransactions_Receipts(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	proc := NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+	proc.SetBalance(origin, 100)
+
+	applied := createTransaction(t, 0, types.Address{1}, 10, 3, signer)
+	badNonce := createTransaction(t, 5, types.Address{1}, 10, 3, signer)
+	noFunds := createTransaction(t, 1, types.Address{1}, 1000, 3, signer)
+	failed, err := proc.ApplyTransactions(1, []*types.Transaction{noFunds, badNonce, applied})
+	r.NoError(err)
+	r.Equal(2, failed)
+
+	receipt, err := proc.GetReceipt(applied.ID())
+	r.NoError(err)
+	r.Equal(types.Receipt{
+		TxID:    applied.ID(),
+		Result:  types.TxResultApplied,
+		GasUsed: types.GasTransfer,
+		Fee:     3 * types.GasTransfer,
+		Layer:   1,
+	}, *receipt)
+
+	receipt, err = proc.GetReceipt(badNonce.ID())
+	r.NoError(err)
+	r.Equal(types.TxResultBadNonce, receipt.Result)
+	r.Equal(errNonce, receipt.Reason)
+	r.Equal(types.LayerID(1), receipt.Layer)
+	r.Zero(receipt.Fee)
+
+	receipt, err = proc.GetReceipt(noFunds.ID())
+	r.NoError(err)
+	r.Equal(types.TxResultInsufficientFunds, receipt.Result)
+	r.Equal(errFunds, receipt.Reason)
+
+	// a tx that was applied keeps its receipt if it's included again in a later layer
+	_, err = proc.ApplyTransactions(2, []*types.Transaction{applied})
+	r.NoError(err)
+	receipt, err = proc.GetReceipt(applied.ID())
+	r.NoError(err)
+	r.Equal(types.TxResultApplied, receipt.Result)
+	r.Equal(types.LayerID(1), receipt.Layer)
+
+	_, err = proc.GetReceipt(types.TransactionID{1})
+	r.Error(err)
+}
+
+func TestTransactionProcessor_GetAccountAtLayer(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	proc := NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	signer := signing.NewEdSigner()
+	origin := SignerToAddr(signer)
+	proc.SetBalance(origin, 100)
+
+	_, err := proc.ApplyTransactions(1, []*types.Transaction{createTransaction(t, 0, types.Address{1}, 10, 1, signer)})
+	r.NoError(err)
+	_, err = proc.ApplyTransactions(2, []*types.Transaction{createTransaction(t, 1, types.Address{1}, 20, 1, signer)})
+	r.NoError(err)
+
+	account, err := proc.GetAccountAtLayer(origin, 1)
+	r.NoError(err)
+	r.Equal(types.AccountState{Nonce: 1, Balance: 100 - 10 - types.GasTransfer}, *account)
+	account, err = proc.GetAccountAtLayer(types.Address{1}, 1)
+	r.NoError(err)
+	r.Equal(uint64(10), account.Balance)
+	account, err = proc.GetAccountAtLayer(origin, 2)
+	r.NoError(err)
+	r.Equal(types.AccountState{Nonce: 2, Balance: 100 - 30 - 2*types.GasTransfer}, *account)
+
+	// accounts that didn't exist yet are empty
+	account, err = proc.GetAccountAtLayer(types.Address{2}, 1)
+	r.NoError(err)
+	r.Equal(types.AccountState{}, *account)
+
+	_, err = proc.GetAccountAtLayer(origin, 3)
+	r.Error(err)
+
+	proof, err := proc.GetAccountProof(origin, 1)
+	r.NoError(err)
+	root, err := proc.GetLayerStateRoot(1)
+	r.NoError(err)
+	r.Equal(root, proof.Root)
+	r.Equal(types.LayerID(1), proof.Layer)
+	r.Equal(types.AccountState{Nonce: 1, Balance: 100 - 10 - types.GasTransfer}, proof.Account)
+	r.NotEmpty(proof.Nodes)
+}
+
+func TestTransactionProcessor_StateHistory(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	stateDb, processorDb := database.NewMemDatabase(), database.NewMemDatabase()
+	proc := NewTransactionProcessor(stateDb, processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	proc.StateHistory = 2
+	proc.StateCheckpointInterval = 3
+
+	for l := types.LayerID(1); l <= 8; l++ {
+		r.NoError(proc.ApplyRewards(l, []types.Address{{byte(l)}}, big.NewInt(10)))
+		_, err := proc.ApplyTransactions(l, nil)
+		r.NoError(err)
+	}
+
+	// the states of the last two layers and of the latest checkpoint are kept, the older checkpoint was pruned from the
+	// disk when the latest was persisted
+	for _, l := range []types.LayerID{6, 7, 8} {
+		account, err := proc.GetAccountAtLayer(types.Address{byte(l)}, l)
+		r.NoError(err, "layer %v", l)
+		r.Equal(uint64(10), account.Balance)
+	}
+	for _, l := range []types.LayerID{1, 2, 3, 4, 5} {
+		_, err := proc.GetAccountAtLayer(types.Address{byte(l)}, l)
+		r.Error(err, "layer %v", l)
+		r.Contains(err.Error(), "no longer kept")
+	}
+
+	// reverting to a layer in the state history drops the states of the layers after it
+	r.NoError(proc.LoadState(7))
+	r.Equal(uint64(0), proc.GetBalance(types.Address{8}))
+	r.NoError(proc.ApplyRewards(8, []types.Address{{8}}, big.NewInt(20)))
+	_, err := proc.ApplyTransactions(8, nil)
+	r.NoError(err)
+	account, err := proc.GetAccountAtLayer(types.Address{8}, 8)
+	r.NoError(err)
+	r.Equal(uint64(20), account.Balance)
+
+	// the states of the layers after the latest checkpoint are lost if they weren't persisted
+	restarted := NewTransactionProcessor(stateDb, processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	r.Error(restarted.LoadState(8))
+	r.NoError(restarted.LoadState(6))
+
+	r.NoError(proc.PersistState())
+	restarted = NewTransactionProcessor(stateDb, processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	r.NoError(restarted.LoadState(8))
+	r.Equal(uint64(20), restarted.GetBalance(types.Address{8}))
+}
+
+func TestTransactionProcessor_PruneCheckpoints(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	stateDb := database.NewMemDatabase()
+	proc := NewTransactionProcessor(stateDb, database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	proc.StateHistory = 2
+	proc.StateCheckpointInterval = 2
+	// every node is persisted as soon as it's added
+	proc.StateCacheLimit = 1
+	// a key of the same length as a trie node's, which isn't one
+	unrelated := types.CalcHash32([]byte("unrelated"))
+	r.NoError(stateDb.Put(unrelated.Bytes(), []byte("value")))
+
+	for l := types.LayerID(1); l <= 12; l++ {
+		r.NoError(proc.ApplyRewards(l, []types.Address{{byte(l)}}, big.NewInt(10)))
+		_, err := proc.ApplyTransactions(l, nil)
+		r.NoError(err)
+	}
+	// only the nodes of the tries of the layers in the state history are left on the disk
+	kept := make(map[types.Hash32]struct{})
+	for _, l := range []types.LayerID{11, 12} {
+		root, err := proc.GetLayerStateRoot(l)
+		r.NoError(err)
+		r.NoError(proc.markTrieNodes(root, kept))
+	}
+	has, err := stateDb.Has(unrelated.Bytes())
+	r.NoError(err)
+	r.True(has)
+	var nodes int
+	for _, key := range stateDb.Keys() {
+		if len(key) != types.Hash32Length || bytes.Equal(key, unrelated.Bytes()) {
+			continue
+		}
+		nodes++
+		r.Contains(kept, types.BytesToHash(key))
+	}
+	r.Equal(len(kept), nodes)
+	for _, l := range []types.LayerID{11, 12} {
+		account, err := proc.GetAccountAtLayer(types.Address{byte(l)}, l)
+		r.NoError(err, "layer %v", l)
+		r.Equal(uint64(10), account.Balance)
+	}
+	_, err = proc.GetAccountAtLayer(types.Address{10}, 10)
+	r.Error(err)
+	r.Contains(err.Error(), "no longer kept")
+}
+
+func TestTransactionProcessor_RevertLayers(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	proc := NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+	createAccount(proc, origin, 1000, 0)
+
+	var txs []*types.Transaction
+	for l := types.LayerID(1); l <= 3; l++ {
+		tx := createTransaction(t, uint64(l-1), types.Address{1}, 10, 1, signer)
+		_, err := proc.ApplyTransactions(l, []*types.Transaction{tx})
+		r.NoError(err)
+		txs = append(txs, tx)
+	}
+	// a tx that failed to apply in the last layer has a receipt only
+	failed := createTransaction(t, 10, types.Address{1}, 10, 1, signer)
+	_, err := proc.ApplyTransactions(3, []*types.Transaction{failed})
+	r.NoError(err)
+
+	r.NoError(proc.LoadState(1))
+	// the tx of layer 1 is passed too, since it may be included again in a later layer
+	r.NoError(proc.RevertLayers(1, 3, []types.TransactionID{txs[0].ID(), txs[1].ID(), txs[2].ID(), failed.ID()}))
+
+	r.NotNil(proc.GetLayerApplied(txs[0].ID()))
+	_, err = proc.GetReceipt(txs[0].ID())
+	r.NoError(err)
+	_, err = proc.GetLayerStateRoot(1)
+	r.NoError(err)
+	for _, tx := range append(txs[1:], failed) {
+		r.Nil(proc.GetLayerApplied(tx.ID()))
+		_, err := proc.GetReceipt(tx.ID())
+		r.Equal(database.ErrNotFound, err)
+	}
+	for _, l := range []types.LayerID{2, 3} {
+		_, err := proc.GetLayerStateRoot(l)
+		r.Equal(database.ErrNotFound, err)
+	}
+}
+
 func TestTransactionProcessor_ApplyTransactionTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessorStateSuite))
 }
 
+type meshProjectorMock struct{}
+
+func (meshProjectorMock) GetProjection(_ types.Address, prevNonce, prevBalance uint64) (nonce, balance uint64, err error) {
+	return prevNonce, prevBalance, nil
+}
+
+func TestTransactionProcessor_RestoreTxPool(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	journal := database.NewMemDatabase()
+	pool, err := NewPersistentTxMemPool(DefaultPoolConfig(), journal)
+	r.NoError(err)
+
+	signer := signing.NewEdSigner()
+	unknown := signing.NewEdSigner()
+	txs := []*types.Transaction{
+		createTransaction(t, 6, types.Address{1}, 10, 1, signer), // valid once nonce 5 is restored first
+		createTransaction(t, 5, types.Address{1}, 10, 1, signer),
+		createTransaction(t, 5, types.Address{1}, 10, 2, signer),  // same nonce, higher fee: kept instead of the above
+		createTransaction(t, 7, types.Address{1}, 500, 1, signer), // overdrafts the account
+		createTransaction(t, 0, types.Address{1}, 10, 1, unknown), // origin doesn't exist
+	}
+	for _, tx := range txs {
+		pool.Put(tx.ID(), tx)
+	}
+
+	// simulate a restart: a new pool on the same journal, and a processor with the state it had
+	pool, err = NewPersistentTxMemPool(DefaultPoolConfig(), journal)
+	r.NoError(err)
+	proc := NewTransactionProcessor(database.NewMemDatabase(), appliedTxsMock{},
+		pendingtxs.NewMeshAndPoolProjector(meshProjectorMock{}, pool), pool, lg)
+	createAccount(proc, types.BytesToAddress(signer.PublicKey().Bytes()), 100, 5)
+
+	r.NoError(proc.RestoreTxPool())
+	for i, tx := range txs {
+		_, err := pool.Get(tx.ID())
+		if i == 0 || i == 2 {
+			r.NoError(err, "tx %d", i)
+		} else {
+			r.Error(err, "tx %d", i)
+		}
+	}
+	// the restored txs were journaled again
+	r.Equal(2, journal.Len())
+}
+
+func TestTransactionProcessor_ValidateAndAddTxToPool_Replace(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	pool := NewTxMemPool()
+	proc := NewTransactionProcessor(database.NewMemDatabase(), appliedTxsMock{},
+		pendingtxs.NewMeshAndPoolProjector(meshProjectorMock{}, pool), pool, lg)
+	signer := signing.NewEdSigner()
+	createAccount(proc, types.BytesToAddress(signer.PublicKey().Bytes()), 100, 5)
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 40, 10, signer)
+	tx2 := createTransaction(t, 6, types.Address{1}, 40, 10, signer)
+	r.NoError(proc.ValidateAndAddTxToPool(tx1))
+	r.NoError(proc.ValidateAndAddTxToPool(tx2))
+
+	// the fee isn't bumped enough
+	r.Error(proc.ValidateAndAddTxToPool(createTransaction(t, 5, types.Address{2}, 40, 10, signer)))
+	// the replacement would overdraft the account, given tx2 that follows it
+	r.Error(proc.ValidateAndAddTxToPool(createTransaction(t, 5, types.Address{2}, 40, 21, signer)))
+
+	tx1b := createTransaction(t, 5, types.Address{2}, 30, 20, signer)
+	r.NoError(proc.ValidateAndAddTxToPool(tx1b))
+	_, err := pool.Get(tx1.ID())
+	r.Error(err)
+	nonce, balance := pool.GetProjection(tx1.Origin(), 5, 100)
+	r.Equal(uint64(7), nonce)
+	r.Equal(uint64(0), balance)
+
+	// nonces that are neither pending nor next are still rejected
+	r.Error(proc.ValidateAndAddTxToPool(createTransaction(t, 4, types.Address{2}, 1, 50, signer)))
+	r.Error(proc.ValidateAndAddTxToPool(createTransaction(t, 8, types.Address{2}, 1, 1, signer)))
+}
+
 func createXdrSignedTransaction(t *testing.T, key ed25519.PrivateKey) *types.Transaction {
 	r := require.New(t)
 	signer, err := signing.NewEdSignerFromBuffer(key)
@@ -569,3 +1138,106 @@ func TestTransactionProcessor_ApplyTransactions(t *testing.T) {
 	assert.NoError(t, err)
 
 }
+
+func TestTransactionProcessor_VerifyStateRoots(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	stateDb, processorDb := database.NewMemDatabase(), database.NewMemDatabase()
+	proc := NewTransactionProcessor(stateDb, processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	proc.StateHistory = 2
+	proc.StateCheckpointInterval = 3
+
+	for l := types.LayerID(1); l <= 5; l++ {
+		r.NoError(proc.ApplyRewards(l, []types.Address{{byte(l)}}, big.NewInt(10)))
+		_, err := proc.ApplyTransactions(l, nil)
+		r.NoError(err)
+	}
+
+	// only the checkpoints must be persisted with a state history
+	restarted := NewTransactionProcessor(stateDb, processorDb, &ProjectorMock{}, NewTxMemPool(), lg)
+	restarted.StateHistory = proc.StateHistory
+	restarted.StateCheckpointInterval = proc.StateCheckpointInterval
+	problems, err := restarted.VerifyStateRoots()
+	r.NoError(err)
+	r.Empty(problems)
+
+	restarted.StateHistory = 0
+	problems, err = restarted.VerifyStateRoots()
+	r.NoError(err)
+	r.Len(problems, 4)
+
+	r.NoError(processorDb.Delete(getStateRootLayerKey(4)))
+	restarted.StateHistory = proc.StateHistory
+	problems, err = restarted.VerifyStateRoots()
+	r.NoError(err)
+	r.Len(problems, 1)
+
+	// the whole trie of the checkpoint is walked, not only its root
+	root, err := restarted.GetLayerStateRoot(3)
+	r.NoError(err)
+	nodes := make(map[types.Hash32]struct{})
+	r.NoError(restarted.markTrieNodes(root, nodes))
+	delete(nodes, root)
+	r.NotEmpty(nodes)
+	for hash := range nodes {
+		r.NoError(stateDb.Delete(hash.Bytes()))
+		break
+	}
+	problems, err = restarted.VerifyStateRoots()
+	r.NoError(err)
+	r.Len(problems, 2)
+}
+
+func TestTransactionProcessor_ExportImportState(t *testing.T) {
+	r := require.New(t)
+	lg := log.NewDefault("proc_logger")
+	proc := NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	for l := types.LayerID(1); l <= 3; l++ {
+		r.NoError(proc.ApplyRewards(l, []types.Address{{byte(l)}, {byte(l + 10)}}, big.NewInt(10)))
+		_, err := proc.ApplyTransactions(l, nil)
+		r.NoError(err)
+	}
+
+	type node struct {
+		hash types.Hash32
+		blob []byte
+	}
+	var nodes []node
+	var addrs [][]byte
+	root, err := proc.ExportState(3, func(hash types.Hash32, blob []byte) error {
+		nodes = append(nodes, node{hash, blob})
+		return nil
+	}, func(addr []byte) error {
+		addrs = append(addrs, addr)
+		return nil
+	})
+	r.NoError(err)
+	r.Equal(proc.GetStateRoot(), root)
+	r.Len(addrs, 6)
+	r.True(len(nodes) > 1)
+
+	imported := NewTransactionProcessor(database.NewMemDatabase(), database.NewMemDatabase(), &ProjectorMock{}, NewTxMemPool(), lg)
+	si := imported.NewStateImport(3, root)
+	// a node that doesn't match its hash is rejected
+	r.Error(si.AddNode(nodes[0].hash, append([]byte{0}, nodes[0].blob...)))
+	for _, n := range nodes[:len(nodes)-1] {
+		r.NoError(si.AddNode(n.hash, n.blob))
+	}
+	// the trie is incomplete without its last node
+	r.Error(si.Finish())
+	r.NoError(si.AddNode(nodes[len(nodes)-1].hash, nodes[len(nodes)-1].blob))
+	for _, addr := range addrs {
+		r.NoError(si.AddPreimage(addr))
+	}
+	r.NoError(si.Finish())
+
+	r.Equal(root, imported.GetStateRoot())
+	stateRoot, err := imported.GetLayerStateRoot(3)
+	r.NoError(err)
+	r.Equal(root, stateRoot)
+	expected, err := proc.GetAllAccounts()
+	r.NoError(err)
+	accounts, err := imported.GetAllAccounts()
+	r.NoError(err)
+	r.Equal(expected, accounts)
+}
@@ -0,0 +1,125 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/crypto"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/trie"
+)
+
+// ExportState walks the state trie of the given layer and returns its root. node is called with the hash and encoding
+// of each node of the trie, parents before their children, and preimage with the address of each account in it.
+func (tp *TransactionProcessor) ExportState(layer types.LayerID, node func(hash types.Hash32, blob []byte) error, preimage func(addr []byte) error) (types.Hash32, error) {
+	root, err := tp.GetLayerStateRoot(layer)
+	if err != nil {
+		return types.Hash32{}, fmt.Errorf("no state root for layer %v: %v", layer, err)
+	}
+	tr, err := trie.NewSecure(root, tp.trie, 0)
+	if err != nil {
+		return types.Hash32{}, fmt.Errorf("failed to open state at layer %v: %v", layer, err)
+	}
+	it := tr.NodeIterator(nil)
+	for it.Next(true) {
+		// nodes small enough to be embedded in their parents have no hash of their own
+		if hash := it.Hash(); hash != (types.Hash32{}) {
+			blob, err := tp.trie.Node(hash)
+			if err != nil {
+				return types.Hash32{}, fmt.Errorf("failed to read state node %v: %v", hash.ShortString(), err)
+			}
+			if err := node(hash, blob); err != nil {
+				return types.Hash32{}, err
+			}
+		}
+		if it.Leaf() {
+			addr := tr.GetKey(it.LeafKey())
+			if addr == nil {
+				return types.Hash32{}, fmt.Errorf("missing address of account with hash %x", it.LeafKey())
+			}
+			if err := preimage(addr); err != nil {
+				return types.Hash32{}, err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return types.Hash32{}, fmt.Errorf("failed to walk state at layer %v: %v", layer, err)
+	}
+	return root, nil
+}
+
+// StateImport writes a state trie exported by ExportState to the state database. Only the nodes of the trie with the
+// expected root are written, after checking that they match their hashes.
+type StateImport struct {
+	tp    *TransactionProcessor
+	layer types.LayerID
+	root  types.Hash32
+	sync  *trie.Sync
+	batch database.Batch
+}
+
+// NewStateImport starts importing the state trie with the given root, as the state of the given layer.
+func (tp *TransactionProcessor) NewStateImport(layer types.LayerID, root types.Hash32) *StateImport {
+	return &StateImport{
+		tp:    tp,
+		layer: layer,
+		root:  root,
+		sync:  trie.NewSync(root, tp.trie.DiskDB(), nil),
+		batch: tp.diskDb.NewBatch(),
+	}
+}
+
+// AddNode writes a node of the trie. Nodes must be added after their parents. Nodes that aren't part of the trie, or
+// are already in the database, are skipped.
+func (si *StateImport) AddNode(hash types.Hash32, blob []byte) error {
+	if crypto.Keccak256Hash(blob) != hash {
+		return fmt.Errorf("state node %v doesn't match its hash", hash.ShortString())
+	}
+	if _, _, err := si.sync.Process([]trie.SyncResult{{Hash: hash, Data: blob}}); err != nil {
+		if err == trie.ErrNotRequested {
+			return nil
+		}
+		return fmt.Errorf("failed to process state node %v: %v", hash.ShortString(), err)
+	}
+	if _, err := si.sync.Commit(si.batch); err != nil {
+		return fmt.Errorf("failed to write state node %v: %v", hash.ShortString(), err)
+	}
+	return si.flush(database.IdealBatchSize)
+}
+
+// AddPreimage writes the address of an account, so the accounts of the state can be listed.
+func (si *StateImport) AddPreimage(addr []byte) error {
+	if err := si.batch.Put(trie.PreimageKey(crypto.Keccak256Hash(addr)), addr); err != nil {
+		return fmt.Errorf("failed to write address %x: %v", addr, err)
+	}
+	return si.flush(database.IdealBatchSize)
+}
+
+func (si *StateImport) flush(limit int) error {
+	if si.batch.ValueSize() < limit {
+		return nil
+	}
+	if err := si.batch.Write(); err != nil {
+		return fmt.Errorf("failed to write state nodes: %v", err)
+	}
+	si.batch.Reset()
+	return nil
+}
+
+// Finish checks that all the nodes of the trie were added, records the trie's root as the state root of the layer and
+// loads the state.
+func (si *StateImport) Finish() error {
+	if missing := si.sync.Pending(); missing > 0 {
+		return fmt.Errorf("state trie with root %v is incomplete, %d nodes are missing", si.root.ShortString(), missing)
+	}
+	if err := si.flush(0); err != nil {
+		return err
+	}
+	if _, err := New(si.root, si.tp.db); err != nil {
+		return fmt.Errorf("failed to open imported state with root %v: %v", si.root.ShortString(), err)
+	}
+	if err := si.tp.addState(si.root, si.layer); err != nil {
+		return fmt.Errorf("failed to record state root: %v", err)
+	}
+	return si.tp.LoadState(si.layer)
+}
@@ -96,6 +96,27 @@ func TestLookupPastState(t *testing.T) {
 
 }
 
+func TestAccountControl(t *testing.T) {
+	sdb := NewDatabase(database.NewMemDatabase())
+	st, _ := New(types.Hash32{}, sdb)
+	control := types.AccountControl{
+		Threshold:   2,
+		Owners:      []types.Address{toAddr([]byte{0x02}), toAddr([]byte{0x03})},
+		UnlockLayer: 7,
+	}
+	st.SetBalance(toAddr([]byte{0x01}), 22)
+	st.SetAccountControl(toAddr([]byte{0x01}), control)
+	root, err := st.Commit()
+	assert.NoError(t, err)
+
+	// the control survives encoding the account into the trie
+	st, err = New(root, sdb)
+	assert.NoError(t, err)
+	assert.Equal(t, &control, st.GetAccountControl(toAddr([]byte{0x01})))
+	assert.Equal(t, uint64(22), st.GetBalance(toAddr([]byte{0x01})))
+	assert.Nil(t, st.GetAccountControl(toAddr([]byte{0x02})))
+}
+
 func (s *StateSuite) SetUpTest(t *testing.T) {
 	s.db = database.NewMemDatabase()
 	s.state, _ = New(types.Hash32{}, NewDatabase(s.db))
@@ -4,27 +4,80 @@ import (
 	"errors"
 	"fmt"
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/events"
+	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/pendingtxs"
 	"github.com/spacemeshos/go-spacemesh/rand"
 	"sync"
 )
 
+// PoolConfig defines the configuration options for the transaction mempool
+type PoolConfig struct {
+	SelectionPolicy  string `mapstructure:"tx-selection-policy"`         // how txs are selected for blocks: "fee" or "random"
+	MaxTxs           int    `mapstructure:"tx-pool-max-txs"`             // max number of txs in the pool, 0 for no limit
+	MaxTxsPerAccount int    `mapstructure:"tx-pool-max-txs-per-account"` // max number of txs per origin, 0 for no limit
+	ReplaceFeeBump   uint64 `mapstructure:"tx-replace-fee-bump"`         // min fee increase (percent) to replace a pending tx
+}
+
+// DefaultPoolConfig returns the default PoolConfig
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		SelectionPolicy:  SelectByFee,
+		MaxTxs:           100000,
+		MaxTxsPerAccount: 1000,
+		ReplaceFeeBump:   10,
+	}
+}
+
 // TxMempool is a struct that holds txs received via gossip network
 type TxMempool struct {
 	txs      map[types.TransactionID]*types.Transaction
 	accounts map[types.Address]*pendingtxs.AccountPendingTxs
 	txByAddr map[types.Address]map[types.TransactionID]struct{}
+	added    map[types.TransactionID]uint64 // insertion sequence number of each tx, used to evict older txs first
+	seq      uint64
+	selector TxSelector
+	cfg      PoolConfig
+	journal  database.Database // optional, persists the pool's txs across restarts
 	mu       sync.RWMutex
 }
 
-// NewTxMemPool returns a new TxMempool struct
+// NewTxMemPool returns a new TxMempool struct with the default configuration
 func NewTxMemPool() *TxMempool {
+	pool, err := NewTxMemPoolWithConfig(DefaultPoolConfig())
+	if err != nil {
+		panic(err) // the default config is always valid
+	}
+	return pool
+}
+
+// NewTxMemPoolWithConfig returns a new TxMempool struct configured by cfg. It returns an error if the configuration is
+// invalid
+func NewTxMemPoolWithConfig(cfg PoolConfig) (*TxMempool, error) {
+	selector, err := NewTxSelector(cfg.SelectionPolicy)
+	if err != nil {
+		return nil, err
+	}
 	return &TxMempool{
 		txs:      make(map[types.TransactionID]*types.Transaction),
 		accounts: make(map[types.Address]*pendingtxs.AccountPendingTxs),
 		txByAddr: make(map[types.Address]map[types.TransactionID]struct{}),
+		added:    make(map[types.TransactionID]uint64),
+		selector: selector,
+		cfg:      cfg,
+	}, nil
+}
+
+// NewPersistentTxMemPool returns a new TxMempool that journals its transactions to db. Transactions journaled before a
+// restart can be restored by calling TransactionProcessor.RestoreTxPool.
+func NewPersistentTxMemPool(cfg PoolConfig, db database.Database) (*TxMempool, error) {
+	pool, err := NewTxMemPoolWithConfig(cfg)
+	if err != nil {
+		return nil, err
 	}
+	pool.journal = db
+	return pool, nil
 }
 
 // Get returns transaction by provided id, it returns an error if transaction is not found
@@ -47,10 +100,10 @@ func (t *TxMempool) GetTxIdsByAddress(addr types.Address) []types.TransactionID
 	return ids
 }
 
-// GetTxsForBlock gets a specific number of random txs for a block. This function also receives a state calculation function
-// to allow returning only transactions that will probably be valid
+// GetTxsForBlock gets a specific number of txs for a block, chosen by the pool's selection policy. This function also
+// receives a state calculation function to allow returning only transactions that will probably be valid
 func (t *TxMempool) GetTxsForBlock(numOfTxs int, getState func(addr types.Address) (nonce, balance uint64, err error)) ([]types.TransactionID, []*types.Transaction, error) {
-	var txIds []types.TransactionID
+	var candidates [][]*types.Transaction
 	t.mu.RLock()
 	for addr, account := range t.accounts {
 		nonce, balance, err := getState(addr)
@@ -59,23 +112,21 @@ func (t *TxMempool) GetTxsForBlock(numOfTxs int, getState func(addr types.Addres
 			return nil, nil, fmt.Errorf("failed to get state for addr %s: %v", addr.Short(), err)
 		}
 		accountTxIds, _, _ := account.ValidTxs(nonce, balance)
-		txIds = append(txIds, accountTxIds...)
+		if len(accountTxIds) > 0 {
+			candidates = append(candidates, t.getTxByIds(accountTxIds))
+		}
 	}
 	t.mu.RUnlock()
 
-	if len(txIds) <= numOfTxs {
-		return txIds, t.getTxByIds(txIds), nil
-	}
-
-	var ret []types.TransactionID
-	for idx := range getRandIdxs(numOfTxs, len(txIds)) {
-		//noinspection GoNilness
-		ret = append(ret, txIds[idx])
+	txs := t.selector.SelectTxs(numOfTxs, candidates)
+	var ids []types.TransactionID
+	for _, tx := range txs {
+		ids = append(ids, tx.ID())
 	}
-
-	return ret, t.getTxByIds(ret), nil
+	return ids, txs, nil
 }
 
+// ⚠️ must be called under read-lock
 func (t *TxMempool) getTxByIds(txsIDs []types.TransactionID) (txs []*types.Transaction) {
 	for _, tx := range txsIDs {
 		txs = append(txs, t.txs[tx])
@@ -93,15 +144,178 @@ func getRandIdxs(numOfTxs, spaceSize int) map[uint64]struct{} {
 	return idxs
 }
 
-// Put inserts a transaction into the mem pool. It indexes it by source and dest addresses as well
+// Put inserts a transaction into the mem pool. It indexes it by source and dest addresses as well. If this causes the
+// pool to exceed its configured limits, transactions are evicted (possibly including the new one), see evict
 func (t *TxMempool) Put(id types.TransactionID, tx *types.Transaction) {
 	t.mu.Lock()
+	evicted := t.put(id, tx, nil)
+	t.mu.Unlock()
+	reportPut(tx, nil, evicted)
+}
+
+// Replace inserts a transaction into the mem pool in place of replaced, a pending transaction with the same origin and
+// nonce, which is dropped from the pool and reported as replaced. Use ReplacedBy to find which transaction, if any, a
+// new transaction replaces. The replacement is validated again under the same lock it's made under, so it fails if
+// replaced is no longer the transaction that tx replaces, e.g. because another replacement was made in the meantime.
+func (t *TxMempool) Replace(id types.TransactionID, tx, replaced *types.Transaction) error {
+	t.mu.Lock()
+	current, err := t.replacedBy(tx)
+	if err == nil && current != replaced {
+		err = fmt.Errorf("replaced tx %v is no longer pending", replaced.ID().ShortString())
+	}
+	if err != nil {
+		t.mu.Unlock()
+		return err
+	}
+	evicted := t.put(id, tx, replaced)
+	t.mu.Unlock()
+	reportPut(tx, replaced, evicted)
+	return nil
+}
+
+// ReplacedBy returns the pending transaction that tx would replace: the one with the same origin and nonce (or the
+// one paying the highest fee, if there are several). It returns nil if there is no such transaction, and an error if
+// tx doesn't pay a high enough fee to replace it.
+func (t *TxMempool) ReplacedBy(tx *types.Transaction) (*types.Transaction, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.replacedBy(tx)
+}
+
+// ⚠️ must be called under read-lock
+func (t *TxMempool) replacedBy(tx *types.Transaction) (*types.Transaction, error) {
+	account, found := t.accounts[tx.Origin()]
+	if !found {
+		return nil, nil
+	}
+	var replaced *types.Transaction
+	for _, id := range account.TxIdsWithNonce(tx.AccountNonce) {
+		if pending := t.txs[id]; replaced == nil || pending.Fee > replaced.Fee {
+			replaced = pending
+		}
+	}
+	if replaced == nil {
+		return nil, nil
+	}
+	if replaced.ID() == tx.ID() {
+		return nil, errors.New("transaction already in mempool")
+	}
+	minFee := replaced.Fee + replaced.Fee*t.cfg.ReplaceFeeBump/100
+	if minFee == replaced.Fee {
+		minFee++ // the replacement must pay more, even if the bump rounds down to zero
+	}
+	if tx.Fee < minFee {
+		return nil, fmt.Errorf("replacement fee too low! Replaced tx %v pays %d, minimum replacement fee: %d, actual: %d",
+			replaced.ID().ShortString(), replaced.Fee, minFee, tx.Fee)
+	}
+	return replaced, nil
+}
+
+// put adds tx to the pool in place of replaced, if it isn't nil, and returns the transactions evicted to make room.
+// ⚠️ must be called under write-lock
+func (t *TxMempool) put(id types.TransactionID, tx, replaced *types.Transaction) []*types.Transaction {
+	if replaced != nil {
+		t.remove(replaced)
+		replacedTxs.Add(1)
+	}
 	t.txs[id] = tx
+	if _, found := t.added[id]; !found {
+		t.seq++
+		t.added[id] = t.seq
+	}
 	t.getOrCreate(tx.Origin()).Add(0, tx)
 	t.addToAddr(tx.Origin(), id)
-	t.addToAddr(tx.Recipient, id)
-	t.mu.Unlock()
+	for _, transfer := range tx.Transfers() {
+		t.addToAddr(transfer.Recipient, id)
+	}
+	t.journalPut(id, tx)
+	return t.enforceLimits(tx.Origin())
+}
+
+func reportPut(tx, replaced *types.Transaction, evicted []*types.Transaction) {
+	if replaced != nil {
+		events.ReportTxReplaced(replaced)
+	}
 	events.ReportNewTx(tx)
+	for _, tx := range evicted {
+		events.ReportTxEvicted(tx)
+	}
+}
+
+// enforceLimits evicts transactions until both the given origin's quota and the global pool size limit are respected.
+// ⚠️ must be called under write-lock
+func (t *TxMempool) enforceLimits(origin types.Address) (evicted []*types.Transaction) {
+	if max := t.cfg.MaxTxsPerAccount; max > 0 {
+		for account := t.accounts[origin]; account != nil && account.Count() > max; account = t.accounts[origin] {
+			evicted = append(evicted, t.evict(t.accountTail(account), evictReasonAccountQuota))
+		}
+	}
+	if max := t.cfg.MaxTxs; max > 0 {
+		for len(t.txs) > max {
+			var worst *types.Transaction
+			for _, account := range t.accounts {
+				if tail := t.accountTail(account); worst == nil || t.evictBefore(tail, worst) {
+					worst = tail
+				}
+			}
+			if worst == nil {
+				break // txs that aren't indexed by account can't be evicted
+			}
+			evicted = append(evicted, t.evict(worst, evictReasonPoolFull))
+		}
+	}
+	return evicted
+}
+
+// accountTail returns the eviction candidate of an account: among the txs with the highest nonce, the one that is
+// evicted first. Only the highest nonce is considered, since evicting a lower one would make all txs following it
+// unusable as well.
+// ⚠️ must be called under read-lock
+func (t *TxMempool) accountTail(account *pendingtxs.AccountPendingTxs) *types.Transaction {
+	var tail *types.Transaction
+	_, ids, _ := account.HighestNonce()
+	for _, id := range ids {
+		if tx := t.txs[id]; tail == nil || t.evictBefore(tx, tail) {
+			tail = tx
+		}
+	}
+	return tail
+}
+
+// evictBefore returns true if a should be evicted before b: txs with a lower fee per gas go first, and among those
+// with the same fee per gas the oldest goes first.
+// ⚠️ must be called under read-lock
+func (t *TxMempool) evictBefore(a, b *types.Transaction) bool {
+	if cmp := compareFeePerGas(a, b); cmp != 0 {
+		return cmp < 0
+	}
+	return t.added[a.ID()] < t.added[b.ID()]
+}
+
+// ⚠️ must be called under write-lock
+func (t *TxMempool) evict(tx *types.Transaction, reason string) *types.Transaction {
+	t.remove(tx)
+	evictedTxs.With("reason", reason).Add(1)
+	return tx
+}
+
+// remove drops a single transaction from the pool, leaving other transactions with the same nonce in place.
+// ⚠️ must be called under write-lock
+func (t *TxMempool) remove(tx *types.Transaction) {
+	id := tx.ID()
+	if account, found := t.accounts[tx.Origin()]; found {
+		account.RemoveTx(tx.AccountNonce, id)
+		if account.IsEmpty() {
+			delete(t.accounts, tx.Origin())
+		}
+	}
+	delete(t.txs, id)
+	delete(t.added, id)
+	t.journalDelete(id)
+	t.removeFromAddr(tx.Origin(), id)
+	for _, transfer := range tx.Transfers() {
+		t.removeFromAddr(transfer.Recipient, id)
+	}
 }
 
 // Invalidate removes transaction from pool
@@ -112,7 +326,15 @@ func (t *TxMempool) Invalidate(id types.TransactionID) {
 			// Once a tx appears in a block we want to invalidate all of this nonce's variants. The mempool currently
 			// only accepts one version, but this future-proofs it.
 			pendingTxs.RemoveNonce(tx.AccountNonce, func(id types.TransactionID) {
+				if variant, found := t.txs[id]; found {
+					t.removeFromAddr(variant.Origin(), id)
+					for _, transfer := range variant.Transfers() {
+						t.removeFromAddr(transfer.Recipient, id)
+					}
+				}
 				delete(t.txs, id)
+				delete(t.added, id)
+				t.journalDelete(id)
 			})
 			if pendingTxs.IsEmpty() {
 				delete(t.accounts, tx.Origin())
@@ -123,11 +345,95 @@ func (t *TxMempool) Invalidate(id types.TransactionID) {
 			events.ReportTxWithValidity(tx, false)
 		}
 		t.removeFromAddr(tx.Origin(), id)
-		t.removeFromAddr(tx.Recipient, id)
+		for _, transfer := range tx.Transfers() {
+			t.removeFromAddr(transfer.Recipient, id)
+		}
 	}
 	t.mu.Unlock()
 }
 
+const journalPrefix = "tx_"
+
+func journalKey(id types.TransactionID) []byte {
+	return append([]byte(journalPrefix), id.Bytes()...)
+}
+
+// ⚠️ must be called under write-lock
+func (t *TxMempool) journalPut(id types.TransactionID, tx *types.Transaction) {
+	if t.journal == nil {
+		return
+	}
+	txBytes, err := types.TransactionToBytes(tx)
+	if err != nil {
+		log.With().Error("failed to serialize tx for mempool journal", id, log.Err(err))
+		return
+	}
+	if err := t.journal.Put(journalKey(id), txBytes); err != nil {
+		log.With().Error("failed to write tx to mempool journal", id, log.Err(err))
+	}
+}
+
+// ⚠️ must be called under write-lock
+func (t *TxMempool) journalDelete(id types.TransactionID) {
+	if t.journal == nil {
+		return
+	}
+	if err := t.journal.Delete(journalKey(id)); err != nil {
+		log.With().Error("failed to delete tx from mempool journal", id, log.Err(err))
+	}
+}
+
+// ReadJournal returns the transactions that were journaled by the pool, typically before the node restarted. The
+// transactions are not added to the pool, since they may no longer be valid: callers should revalidate them, Put the
+// ones that pass (which journals them again) and DiscardJournaled the others. Until then they stay in the journal, so
+// they aren't lost if the node stops while they're restored. The per-account pending txs are not stored, since they
+// are rebuilt when the transactions are put back in the pool.
+func (t *TxMempool) ReadJournal() ([]*types.Transaction, error) {
+	if t.journal == nil {
+		return nil, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var txs []*types.Transaction
+	var malformed [][]byte
+	it := t.journal.Find([]byte(journalPrefix))
+	for it.Next() {
+		if it.Key() == nil {
+			break
+		}
+		tx, err := types.BytesToTransaction(it.Value())
+		if err != nil {
+			log.With().Error("discarding malformed tx in mempool journal", log.String("key", fmt.Sprintf("%x", it.Key())), log.Err(err))
+			malformed = append(malformed, append([]byte(nil), it.Key()...))
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	err := it.Error()
+	it.Release()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mempool journal: %v", err)
+	}
+
+	for _, key := range malformed {
+		if err := t.journal.Delete(key); err != nil {
+			return nil, fmt.Errorf("failed to clear mempool journal: %v", err)
+		}
+	}
+	return txs, nil
+}
+
+// DiscardJournaled removes a transaction returned by ReadJournal from the journal, unless it was put back in the pool.
+func (t *TxMempool) DiscardJournaled(id types.TransactionID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.txs[id]; ok {
+		return
+	}
+	t.journalDelete(id)
+}
+
 // GetProjection returns the estimated nonce and balance for the provided address addr and previous nonce and balance
 // projecting state is done by applying transactions from the pool
 func (t *TxMempool) GetProjection(addr types.Address, prevNonce, prevBalance uint64) (nonce, balance uint64) {
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/rand"
 	"github.com/spacemeshos/go-spacemesh/signing"
 	"github.com/stretchr/testify/require"
@@ -68,7 +69,8 @@ func TestNewTxPoolWithAccounts(t *testing.T) {
 func TestTxPoolWithAccounts_GetRandomTxs(t *testing.T) {
 	r := require.New(t)
 
-	pool := NewTxMemPool()
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectRandom})
+	r.NoError(err)
 	prevNonce := uint64(5)
 	prevBalance := uint64(1000)
 	signer := signing.NewEdSigner()
@@ -106,6 +108,250 @@ func TestTxPoolWithAccounts_GetRandomTxs(t *testing.T) {
 	*/
 }
 
+func TestTxPoolWithAccounts_GetTxsByFee(t *testing.T) {
+	r := require.New(t)
+
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee})
+	r.NoError(err)
+	signer1 := signing.NewEdSigner()
+	signer2 := signing.NewEdSigner()
+
+	// the high fee tx of signer1 must wait for its lower fee predecessor
+	tx1a := createTransaction(t, 5, types.Address{1}, 10, 1, signer1)
+	tx1b := createTransaction(t, 6, types.Address{1}, 10, 10, signer1)
+	tx2a := createTransaction(t, 5, types.Address{2}, 10, 5, signer2)
+	tx2b := createTransaction(t, 6, types.Address{2}, 10, 2, signer2)
+	for _, tx := range []*types.Transaction{tx1a, tx1b, tx2a, tx2b} {
+		pool.Put(tx.ID(), tx)
+	}
+
+	ids, txs, err := pool.GetTxsForBlock(3, getState)
+	r.NoError(err)
+	r.Equal([]types.TransactionID{tx2a.ID(), tx2b.ID(), tx1a.ID()}, ids)
+	r.Equal([]*types.Transaction{tx2a, tx2b, tx1a}, txs)
+
+	ids, _, err = pool.GetTxsForBlock(10, getState)
+	r.NoError(err)
+	r.Equal([]types.TransactionID{tx2a.ID(), tx2b.ID(), tx1a.ID(), tx1b.ID()}, ids)
+}
+
+func TestCompareFeePerGas(t *testing.T) {
+	r := require.New(t)
+	signer := signing.NewEdSigner()
+	newTxWithGas := func(gas, fee uint64) *types.Transaction {
+		tx, err := types.NewSignedTx(0, types.Address{}, 1, gas, fee, signer)
+		r.NoError(err)
+		return tx
+	}
+
+	r.Equal(1, compareFeePerGas(newTxWithGas(100, 20), newTxWithGas(100, 10)))
+	r.Equal(-1, compareFeePerGas(newTxWithGas(200, 20), newTxWithGas(100, 21)))
+	r.Equal(0, compareFeePerGas(newTxWithGas(200, 20), newTxWithGas(100, 20)))
+	r.Equal(0, compareFeePerGas(newTxWithGas(0, 10), newTxWithGas(1, 10)))
+	r.Equal(1, compareFeePerGas(newTxWithGas(1, ^uint64(0)), newTxWithGas(2, ^uint64(0)-1)))
+}
+
+func TestNewTxMemPoolWithConfig_UnknownPolicy(t *testing.T) {
+	r := require.New(t)
+	_, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: "unknown"})
+	r.Error(err)
+}
+
+func TestTxMempool_AccountQuota(t *testing.T) {
+	r := require.New(t)
+
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee, MaxTxsPerAccount: 2})
+	r.NoError(err)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 10, 1, signer)
+	tx2 := createTransaction(t, 6, types.Address{1}, 10, 1, signer)
+	tx3 := createTransaction(t, 7, types.Address{1}, 10, 100, signer)
+	pool.Put(tx1.ID(), tx1)
+	pool.Put(tx2.ID(), tx2)
+	pool.Put(tx3.ID(), tx3)
+
+	// the highest nonce is evicted, even though it pays a higher fee, since it can't be applied before the others
+	_, err = pool.Get(tx3.ID())
+	r.Error(err)
+	r.ElementsMatch([]types.TransactionID{tx1.ID(), tx2.ID()}, pool.GetTxIdsByAddress(origin))
+
+	// a replacement for the last nonce that pays more evicts the cheaper version
+	tx2b := createTransaction(t, 6, types.Address{2}, 10, 5, signer)
+	pool.Put(tx2b.ID(), tx2b)
+	_, err = pool.Get(tx2.ID())
+	r.Error(err)
+	r.ElementsMatch([]types.TransactionID{tx1.ID(), tx2b.ID()}, pool.GetTxIdsByAddress(origin))
+	r.ElementsMatch([]types.TransactionID{tx1.ID()}, pool.GetTxIdsByAddress(types.Address{1}))
+}
+
+func TestTxMempool_InvalidateVariants(t *testing.T) {
+	r := require.New(t)
+
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee, MaxTxsPerAccount: 2})
+	r.NoError(err)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 10, 1, signer)
+	tx1b := createTransaction(t, 5, types.Address{2}, 10, 2, signer)
+	pool.Put(tx1.ID(), tx1)
+	pool.Put(tx1b.ID(), tx1b)
+	r.Len(pool.GetTxIdsByAddress(origin), 2)
+
+	// all the variants of the nonce are dropped from the index of every account they touch
+	pool.Invalidate(tx1.ID())
+	r.Empty(pool.GetTxIdsByAddress(origin))
+	r.Empty(pool.GetTxIdsByAddress(types.Address{1}))
+	r.Empty(pool.GetTxIdsByAddress(types.Address{2}))
+
+	// the dropped variants don't count against the account's quota
+	tx2 := createTransaction(t, 6, types.Address{1}, 10, 1, signer)
+	tx3 := createTransaction(t, 7, types.Address{1}, 10, 1, signer)
+	pool.Put(tx2.ID(), tx2)
+	pool.Put(tx3.ID(), tx3)
+	r.ElementsMatch([]types.TransactionID{tx2.ID(), tx3.ID()}, pool.GetTxIdsByAddress(origin))
+}
+
+func TestTxMempool_MaxTxs(t *testing.T) {
+	r := require.New(t)
+
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee, MaxTxs: 3})
+	r.NoError(err)
+	signer1 := signing.NewEdSigner()
+	signer2 := signing.NewEdSigner()
+	signer3 := signing.NewEdSigner()
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 10, 2, signer1)
+	tx2 := createTransaction(t, 5, types.Address{1}, 10, 2, signer2)
+	tx3 := createTransaction(t, 6, types.Address{1}, 10, 3, signer2)
+	for _, tx := range []*types.Transaction{tx1, tx2, tx3} {
+		pool.Put(tx.ID(), tx)
+	}
+	for _, tx := range []*types.Transaction{tx1, tx2, tx3} {
+		_, err = pool.Get(tx.ID())
+		r.NoError(err)
+	}
+
+	// tx1 and tx3 are the candidates (highest nonce per account), tx1 has the lowest fee
+	tx4 := createTransaction(t, 5, types.Address{1}, 10, 5, signer3)
+	pool.Put(tx4.ID(), tx4)
+	_, err = pool.Get(tx1.ID())
+	r.Error(err)
+
+	// on equal fees the oldest tx is evicted first
+	tx5 := createTransaction(t, 6, types.Address{1}, 10, 3, signer3)
+	pool.Put(tx5.ID(), tx5)
+	_, err = pool.Get(tx3.ID())
+	r.Error(err)
+
+	// a new tx that pays less than everything else is evicted right away
+	tx6 := createTransaction(t, 5, types.Address{1}, 10, 1, signer1)
+	pool.Put(tx6.ID(), tx6)
+	_, err = pool.Get(tx6.ID())
+	r.Error(err)
+
+	for _, tx := range []*types.Transaction{tx2, tx4, tx5} {
+		_, err = pool.Get(tx.ID())
+		r.NoError(err)
+	}
+}
+
+func TestTxMempool_Journal(t *testing.T) {
+	r := require.New(t)
+
+	db := database.NewMemDatabase()
+	pool, err := NewPersistentTxMemPool(PoolConfig{SelectionPolicy: SelectByFee, MaxTxsPerAccount: 2}, db)
+	r.NoError(err)
+	signer := signing.NewEdSigner()
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 10, 1, signer)
+	tx2 := createTransaction(t, 6, types.Address{1}, 10, 1, signer)
+	tx3 := createTransaction(t, 7, types.Address{1}, 10, 1, signer)
+	for _, tx := range []*types.Transaction{tx1, tx2, tx3} {
+		pool.Put(tx.ID(), tx)
+	}
+	pool.Invalidate(tx1.ID())
+	r.Equal(1, db.Len()) // tx1 was invalidated and tx3 evicted
+
+	// a pool created on the same db after a restart finds the journaled tx
+	pool, err = NewPersistentTxMemPool(DefaultPoolConfig(), db)
+	r.NoError(err)
+	txs, err := pool.ReadJournal()
+	r.NoError(err)
+	r.Len(txs, 1)
+	r.Equal(tx2.ID(), txs[0].ID())
+	r.NoError(txs[0].CalcAndSetOrigin())
+	r.Equal(tx2.Origin(), txs[0].Origin())
+	// the tx stays journaled until it's put back in the pool or discarded
+	r.Equal(1, db.Len())
+	pool.Put(txs[0].ID(), txs[0])
+	pool.DiscardJournaled(txs[0].ID())
+	r.Equal(1, db.Len())
+	pool.Invalidate(txs[0].ID())
+	r.Equal(0, db.Len())
+
+	// pools without a journal have nothing to restore
+	txs, err = NewTxMemPool().ReadJournal()
+	r.NoError(err)
+	r.Empty(txs)
+}
+
+func TestTxMempool_Replace(t *testing.T) {
+	r := require.New(t)
+
+	pool, err := NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee, ReplaceFeeBump: 10})
+	r.NoError(err)
+	signer := signing.NewEdSigner()
+	origin := types.BytesToAddress(signer.PublicKey().Bytes())
+
+	tx1 := createTransaction(t, 5, types.Address{1}, 10, 20, signer)
+	pool.Put(tx1.ID(), tx1)
+
+	// no pending tx with this nonce
+	replaced, err := pool.ReplacedBy(createTransaction(t, 6, types.Address{1}, 10, 1, signer))
+	r.NoError(err)
+	r.Nil(replaced)
+
+	// the same tx can't replace itself
+	_, err = pool.ReplacedBy(tx1)
+	r.Error(err)
+
+	// the fee must be bumped by at least 10%
+	_, err = pool.ReplacedBy(createTransaction(t, 5, types.Address{2}, 10, 21, signer))
+	r.Error(err)
+	tx2 := createTransaction(t, 5, types.Address{2}, 10, 22, signer)
+	replaced, err = pool.ReplacedBy(tx2)
+	r.NoError(err)
+	r.Equal(tx1.ID(), replaced.ID())
+
+	// a replacement validated against tx1 fails once tx1 was replaced by another tx
+	tx3 := createTransaction(t, 5, types.Address{2}, 10, 23, signer)
+	replaced3, err := pool.ReplacedBy(tx3)
+	r.NoError(err)
+	r.NoError(pool.Replace(tx2.ID(), tx2, replaced))
+	r.Error(pool.Replace(tx3.ID(), tx3, replaced3))
+	_, err = pool.Get(tx3.ID())
+	r.Error(err)
+	_, err = pool.Get(tx1.ID())
+	r.Error(err)
+	_, err = pool.Get(tx2.ID())
+	r.NoError(err)
+	r.ElementsMatch([]types.TransactionID{tx2.ID()}, pool.GetTxIdsByAddress(origin))
+	r.Empty(pool.GetTxIdsByAddress(types.Address{1}))
+
+	// even without a configured bump, a replacement must pay more
+	pool, err = NewTxMemPoolWithConfig(PoolConfig{SelectionPolicy: SelectByFee})
+	r.NoError(err)
+	pool.Put(tx1.ID(), tx1)
+	_, err = pool.ReplacedBy(createTransaction(t, 5, types.Address{2}, 10, 20, signer))
+	r.Error(err)
+	replaced, err = pool.ReplacedBy(createTransaction(t, 5, types.Address{2}, 10, 21, signer))
+	r.NoError(err)
+	r.Equal(tx1.ID(), replaced.ID())
+}
+
 func TestGetRandIdxs(t *testing.T) {
 	seed := []byte("seedseed")
 	rand.Seed(int64(binary.LittleEndian.Uint64(seed)))
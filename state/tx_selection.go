@@ -0,0 +1,124 @@
+package state
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+)
+
+// Supported transaction selection policies, used as values for PoolConfig.SelectionPolicy
+const (
+	// SelectByFee selects transactions with the highest fee per gas first, while keeping each account's transactions
+	// ordered by nonce
+	SelectByFee = "fee"
+	// SelectRandom selects a uniformly random subset of the valid transactions, which reduces the chance that several
+	// miners pick the same transactions for their blocks in the same layer
+	SelectRandom = "random"
+)
+
+// TxSelector decides which of the valid pending transactions go into a block. Candidates are grouped by account, and
+// each group is ordered by nonce, so that a transaction can only be applied after all the ones preceding it.
+type TxSelector interface {
+	SelectTxs(numOfTxs int, candidates [][]*types.Transaction) []*types.Transaction
+}
+
+// NewTxSelector returns the TxSelector registered under the given policy name
+func NewTxSelector(policy string) (TxSelector, error) {
+	switch policy {
+	case SelectByFee:
+		return FeeSelector{}, nil
+	case SelectRandom:
+		return RandomSelector{}, nil
+	default:
+		return nil, fmt.Errorf("unknown tx selection policy %q", policy)
+	}
+}
+
+// RandomSelector selects a random subset of numOfTxs transactions, ignoring fees and nonce ordering
+type RandomSelector struct{}
+
+// SelectTxs implements TxSelector
+func (RandomSelector) SelectTxs(numOfTxs int, candidates [][]*types.Transaction) []*types.Transaction {
+	var txs []*types.Transaction
+	for _, accountTxs := range candidates {
+		txs = append(txs, accountTxs...)
+	}
+	if len(txs) <= numOfTxs {
+		return txs
+	}
+
+	var ret []*types.Transaction
+	for idx := range getRandIdxs(numOfTxs, len(txs)) {
+		ret = append(ret, txs[idx])
+	}
+	return ret
+}
+
+// FeeSelector greedily selects the transaction with the highest fee per gas among the next transaction (by nonce) of
+// every account, until numOfTxs transactions were selected or no candidates remain. Ties are broken by transaction ID
+// so that the result doesn't depend on the order of the candidates.
+type FeeSelector struct{}
+
+// SelectTxs implements TxSelector
+func (FeeSelector) SelectTxs(numOfTxs int, candidates [][]*types.Transaction) []*types.Transaction {
+	h := make(accountsHeap, 0, len(candidates))
+	for _, accountTxs := range candidates {
+		if len(accountTxs) > 0 {
+			h = append(h, accountTxs)
+		}
+	}
+	heap.Init(&h)
+
+	var ret []*types.Transaction
+	for len(ret) < numOfTxs && h.Len() > 0 {
+		ret = append(ret, h[0][0])
+		if h[0] = h[0][1:]; len(h[0]) == 0 {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+	return ret
+}
+
+// accountsHeap is a max-heap of per-account transaction lists, ordered by the fee per gas of each list's first
+// transaction
+type accountsHeap [][]*types.Transaction
+
+func (h accountsHeap) Len() int { return len(h) }
+
+func (h accountsHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if cmp := compareFeePerGas(a, b); cmp != 0 {
+		return cmp > 0
+	}
+	idA, idB := a.ID(), b.ID()
+	return bytes.Compare(idA[:], idB[:]) < 0
+}
+
+func (h accountsHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *accountsHeap) Push(x interface{}) { *h = append(*h, x.([]*types.Transaction)) }
+
+func (h *accountsHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// compareFeePerGas compares the fee per gas unit offered by two transactions, returning -1, 0 or 1.
+func compareFeePerGas(a, b *types.Transaction) int {
+	priceA, priceB := a.GasPrice(), b.GasPrice()
+	switch {
+	case priceA > priceB:
+		return 1
+	case priceA < priceB:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -141,7 +141,7 @@ func newTxsRequestHandler(s *Syncer, logger log.Log) func(msg []byte) []byte {
 			}
 		}
 
-		bbytes, err := types.InterfaceToBytes(txs)
+		bbytes, err := types.TransactionsToBytes(txs)
 		if err != nil {
 			logger.Error("Error marshaling transactions response message, with ids %v and err:", txs, err)
 			return nil
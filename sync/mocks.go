@@ -77,6 +77,10 @@ func (s mockState) LoadState(types.LayerID) error {
 	return nil
 }
 
+func (s mockState) RevertLayers(types.LayerID, types.LayerID, []types.TransactionID) error {
+	return nil
+}
+
 func (s mockState) GetStateRoot() types.Hash32 {
 	return [32]byte{}
 }
@@ -93,11 +97,27 @@ func (mockState) GetLayerApplied(types.TransactionID) *types.LayerID {
 	panic("implement me")
 }
 
+func (mockState) GetReceipt(types.TransactionID) (*types.Receipt, error) {
+	panic("implement me")
+}
+
+func (mockState) GetAccountControl(types.Address) *types.AccountControl {
+	panic("implement me")
+}
+
+func (mockState) GetAccountAtLayer(types.Address, types.LayerID) (*types.AccountState, error) {
+	panic("implement me")
+}
+
+func (mockState) GetAccountProof(types.Address, types.LayerID) (*types.AccountProof, error) {
+	panic("implement me")
+}
+
 func (mockState) ApplyTransactions(types.LayerID, []*types.Transaction) (int, error) {
 	return 0, nil
 }
 
-func (mockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) {}
+func (mockState) ApplyRewards(types.LayerID, []types.Address, *big.Int) error { return nil }
 
 func (mockState) GetBalance(types.Address) uint64 {
 	panic("implement me")
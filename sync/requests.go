@@ -164,8 +164,7 @@ func atxsAsItems(msg []byte) ([]item, error) {
 }
 
 func txsAsItems(msg []byte) ([]item, error) {
-	var txs []*types.Transaction
-	err := types.BytesToInterface(msg, &txs)
+	txs, err := types.BytesToTransactions(msg)
 	if err != nil || txs == nil {
 		return nil, err
 	}
@@ -131,7 +131,7 @@ var rewardConf = mesh.Config{
 
 func getMeshWithLevelDB(id string) *mesh.Mesh {
 	lg := log.NewDefault(id)
-	mshdb, _ := mesh.NewPersistentMeshDB(id, 5, lg)
+	mshdb, _ := mesh.NewPersistentMeshDB(id, database.LevelDBBackend, 5, lg)
 	atxdbStore, _ := database.NewLDBDatabase(id+"atx", 0, 0, lg.WithOptions(log.Nop))
 	atxdb := activation.NewDB(atxdbStore, &mockIStore{}, mshdb, layersPerEpoch, goldenATXID, &validatorMock{}, lg.WithOptions(log.Nop))
 	return mesh.NewMesh(mshdb, atxdb, rewardConf, &meshValidatorMock{}, &mockTxMemPool{}, &mockState{}, lg.WithOptions(log.Nop))
@@ -1571,7 +1571,7 @@ func TestSyncProtocol_BadResponse(t *testing.T) {
 
 	txHandlerMock := func([]byte) []byte {
 		t.Log("return fake tx")
-		byts, _ := types.InterfaceToBytes(tx())
+		byts, _ := types.TransactionToBytes(tx())
 		return byts
 	}
 
@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: spacemesh/v1/debug_types.proto
+
+package v1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// An opinion of the tortoise on a block
+type TortoiseOpinion int32
+
+const (
+	TortoiseOpinion_TORTOISE_OPINION_UNSPECIFIED TortoiseOpinion = 0
+	TortoiseOpinion_TORTOISE_OPINION_SUPPORT     TortoiseOpinion = 1
+	TortoiseOpinion_TORTOISE_OPINION_AGAINST     TortoiseOpinion = 2
+	TortoiseOpinion_TORTOISE_OPINION_ABSTAIN     TortoiseOpinion = 3
+)
+
+// Enum value maps for TortoiseOpinion.
+var (
+	TortoiseOpinion_name = map[int32]string{
+		0: "TORTOISE_OPINION_UNSPECIFIED",
+		1: "TORTOISE_OPINION_SUPPORT",
+		2: "TORTOISE_OPINION_AGAINST",
+		3: "TORTOISE_OPINION_ABSTAIN",
+	}
+	TortoiseOpinion_value = map[string]int32{
+		"TORTOISE_OPINION_UNSPECIFIED": 0,
+		"TORTOISE_OPINION_SUPPORT":     1,
+		"TORTOISE_OPINION_AGAINST":     2,
+		"TORTOISE_OPINION_ABSTAIN":     3,
+	}
+)
+
+func (x TortoiseOpinion) Enum() *TortoiseOpinion {
+	p := new(TortoiseOpinion)
+	*p = x
+	return p
+}
+
+func (x TortoiseOpinion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TortoiseOpinion) Descriptor() protoreflect.EnumDescriptor {
+	return file_spacemesh_v1_debug_types_proto_enumTypes[0].Descriptor()
+}
+
+func (TortoiseOpinion) Type() protoreflect.EnumType {
+	return &file_spacemesh_v1_debug_types_proto_enumTypes[0]
+}
+
+func (x TortoiseOpinion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TortoiseOpinion.Descriptor instead.
+func (TortoiseOpinion) EnumDescriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountWrapper []*Account `protobuf:"bytes,1,rep,name=account_wrapper,json=accountWrapper,proto3" json:"account_wrapper,omitempty"`
+}
+
+func (x *AccountsResponse) Reset() {
+	*x = AccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_debug_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountsResponse) ProtoMessage() {}
+
+func (x *AccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_debug_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountsResponse.ProtoReflect.Descriptor instead.
+func (*AccountsResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountsResponse) GetAccountWrapper() []*Account {
+	if x != nil {
+		return x.AccountWrapper
+	}
+	return nil
+}
+
+type TortoiseLayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer *LayerNumber `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"` // must be in the tortoise's window
+}
+
+func (x *TortoiseLayerRequest) Reset() {
+	*x = TortoiseLayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_debug_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TortoiseLayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TortoiseLayerRequest) ProtoMessage() {}
+
+func (x *TortoiseLayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_debug_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TortoiseLayerRequest.ProtoReflect.Descriptor instead.
+func (*TortoiseLayerRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TortoiseLayerRequest) GetLayer() *LayerNumber {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+// The opinion of a block of a later layer on a block of the inspected layer
+type TortoiseVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voter   []byte       `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Layer   *LayerNumber `protobuf:"bytes,2,opt,name=layer,proto3" json:"layer,omitempty"` // the layer of the voter
+	Good    bool         `protobuf:"varint,3,opt,name=good,proto3" json:"good,omitempty"`  // the votes of good blocks are counted by the verifying tortoise
+	Weight  uint64       `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Support uint64       `protobuf:"varint,5,opt,name=support,proto3" json:"support,omitempty"` // the unweighted votes of the voter for the block
+	Against uint64       `protobuf:"varint,6,opt,name=against,proto3" json:"against,omitempty"` // the unweighted votes of the voter against the block
+}
+
+func (x *TortoiseVote) Reset() {
+	*x = TortoiseVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_debug_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TortoiseVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TortoiseVote) ProtoMessage() {}
+
+func (x *TortoiseVote) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_debug_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TortoiseVote.ProtoReflect.Descriptor instead.
+func (*TortoiseVote) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TortoiseVote) GetVoter() []byte {
+	if x != nil {
+		return x.Voter
+	}
+	return nil
+}
+
+func (x *TortoiseVote) GetLayer() *LayerNumber {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+func (x *TortoiseVote) GetGood() bool {
+	if x != nil {
+		return x.Good
+	}
+	return false
+}
+
+func (x *TortoiseVote) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TortoiseVote) GetSupport() uint64 {
+	if x != nil {
+		return x.Support
+	}
+	return 0
+}
+
+func (x *TortoiseVote) GetAgainst() uint64 {
+	if x != nil {
+		return x.Against
+	}
+	return 0
+}
+
+// The tortoise's view of a block of the inspected layer
+type TortoiseBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Good          bool            `protobuf:"varint,2,opt,name=good,proto3" json:"good,omitempty"`
+	Weight        uint64          `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Input         TortoiseOpinion `protobuf:"varint,4,opt,name=input,proto3,enum=spacemesh.v1.TortoiseOpinion" json:"input,omitempty"` // the node's own vote on the block, from the layer's input vector
+	Support       uint64          `protobuf:"varint,5,opt,name=support,proto3" json:"support,omitempty"`                               // the weighted votes of the good blocks for the block
+	Against       uint64          `protobuf:"varint,6,opt,name=against,proto3" json:"against,omitempty"`                               // the weighted votes of the good blocks against the block
+	GlobalOpinion TortoiseOpinion `protobuf:"varint,7,opt,name=global_opinion,json=globalOpinion,proto3,enum=spacemesh.v1.TortoiseOpinion" json:"global_opinion,omitempty"`
+	Votes         []*TortoiseVote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"` // ordered by layer
+}
+
+func (x *TortoiseBlock) Reset() {
+	*x = TortoiseBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_debug_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TortoiseBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TortoiseBlock) ProtoMessage() {}
+
+func (x *TortoiseBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_debug_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TortoiseBlock.ProtoReflect.Descriptor instead.
+func (*TortoiseBlock) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TortoiseBlock) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TortoiseBlock) GetGood() bool {
+	if x != nil {
+		return x.Good
+	}
+	return false
+}
+
+func (x *TortoiseBlock) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TortoiseBlock) GetInput() TortoiseOpinion {
+	if x != nil {
+		return x.Input
+	}
+	return TortoiseOpinion_TORTOISE_OPINION_UNSPECIFIED
+}
+
+func (x *TortoiseBlock) GetSupport() uint64 {
+	if x != nil {
+		return x.Support
+	}
+	return 0
+}
+
+func (x *TortoiseBlock) GetAgainst() uint64 {
+	if x != nil {
+		return x.Against
+	}
+	return 0
+}
+
+func (x *TortoiseBlock) GetGlobalOpinion() TortoiseOpinion {
+	if x != nil {
+		return x.GlobalOpinion
+	}
+	return TortoiseOpinion_TORTOISE_OPINION_UNSPECIFIED
+}
+
+func (x *TortoiseBlock) GetVotes() []*TortoiseVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type TortoiseLayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer     *LayerNumber     `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	Last      *LayerNumber     `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`             // the last layer handled by the tortoise
+	Verified  *LayerNumber     `protobuf:"bytes,3,opt,name=verified,proto3" json:"verified,omitempty"`     // the last layer verified by the tortoise
+	Threshold float64          `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"` // the weight of votes needed for a global opinion on the layer's blocks
+	Blocks    []*TortoiseBlock `protobuf:"bytes,5,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Reason    string           `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"` // why the layer isn't verified, empty if it is
+}
+
+func (x *TortoiseLayerResponse) Reset() {
+	*x = TortoiseLayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_debug_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TortoiseLayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TortoiseLayerResponse) ProtoMessage() {}
+
+func (x *TortoiseLayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_debug_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TortoiseLayerResponse.ProtoReflect.Descriptor instead.
+func (*TortoiseLayerResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_debug_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TortoiseLayerResponse) GetLayer() *LayerNumber {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+func (x *TortoiseLayerResponse) GetLast() *LayerNumber {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *TortoiseLayerResponse) GetVerified() *LayerNumber {
+	if x != nil {
+		return x.Verified
+	}
+	return nil
+}
+
+func (x *TortoiseLayerResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *TortoiseLayerResponse) GetBlocks() []*TortoiseBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *TortoiseLayerResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_spacemesh_v1_debug_types_proto protoreflect.FileDescriptor
+
+var file_spacemesh_v1_debug_types_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x25,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x52, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x14, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xb5, 0x01, 0x0a,
+	0x0c, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x67, 0x6f, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x0d, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x6f, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x6f, 0x6e,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x69, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x4f, 0x70, 0x69, 0x6e, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4f, 0x70, 0x69, 0x6e, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x15, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2d,
+	0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a,
+	0x8d, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x72, 0x74, 0x6f, 0x69, 0x73, 0x65, 0x4f, 0x70, 0x69, 0x6e,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4f, 0x52, 0x54, 0x4f, 0x49, 0x53, 0x45, 0x5f,
+	0x4f, 0x50, 0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x52, 0x54, 0x4f, 0x49, 0x53,
+	0x45, 0x5f, 0x4f, 0x50, 0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x52, 0x54, 0x4f, 0x49, 0x53, 0x45, 0x5f,
+	0x4f, 0x50, 0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x47, 0x41, 0x49, 0x4e, 0x53, 0x54, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x52, 0x54, 0x4f, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x50,
+	0x49, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x03, 0x42,
+	0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spacemesh_v1_debug_types_proto_rawDescOnce sync.Once
+	file_spacemesh_v1_debug_types_proto_rawDescData = file_spacemesh_v1_debug_types_proto_rawDesc
+)
+
+func file_spacemesh_v1_debug_types_proto_rawDescGZIP() []byte {
+	file_spacemesh_v1_debug_types_proto_rawDescOnce.Do(func() {
+		file_spacemesh_v1_debug_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_spacemesh_v1_debug_types_proto_rawDescData)
+	})
+	return file_spacemesh_v1_debug_types_proto_rawDescData
+}
+
+var file_spacemesh_v1_debug_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_spacemesh_v1_debug_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_spacemesh_v1_debug_types_proto_goTypes = []interface{}{
+	(TortoiseOpinion)(0),          // 0: spacemesh.v1.TortoiseOpinion
+	(*AccountsResponse)(nil),      // 1: spacemesh.v1.AccountsResponse
+	(*TortoiseLayerRequest)(nil),  // 2: spacemesh.v1.TortoiseLayerRequest
+	(*TortoiseVote)(nil),          // 3: spacemesh.v1.TortoiseVote
+	(*TortoiseBlock)(nil),         // 4: spacemesh.v1.TortoiseBlock
+	(*TortoiseLayerResponse)(nil), // 5: spacemesh.v1.TortoiseLayerResponse
+	(*Account)(nil),               // 6: spacemesh.v1.Account
+	(*LayerNumber)(nil),           // 7: spacemesh.v1.LayerNumber
+}
+var file_spacemesh_v1_debug_types_proto_depIdxs = []int32{
+	6,  // 0: spacemesh.v1.AccountsResponse.account_wrapper:type_name -> spacemesh.v1.Account
+	7,  // 1: spacemesh.v1.TortoiseLayerRequest.layer:type_name -> spacemesh.v1.LayerNumber
+	7,  // 2: spacemesh.v1.TortoiseVote.layer:type_name -> spacemesh.v1.LayerNumber
+	0,  // 3: spacemesh.v1.TortoiseBlock.input:type_name -> spacemesh.v1.TortoiseOpinion
+	0,  // 4: spacemesh.v1.TortoiseBlock.global_opinion:type_name -> spacemesh.v1.TortoiseOpinion
+	3,  // 5: spacemesh.v1.TortoiseBlock.votes:type_name -> spacemesh.v1.TortoiseVote
+	7,  // 6: spacemesh.v1.TortoiseLayerResponse.layer:type_name -> spacemesh.v1.LayerNumber
+	7,  // 7: spacemesh.v1.TortoiseLayerResponse.last:type_name -> spacemesh.v1.LayerNumber
+	7,  // 8: spacemesh.v1.TortoiseLayerResponse.verified:type_name -> spacemesh.v1.LayerNumber
+	4,  // 9: spacemesh.v1.TortoiseLayerResponse.blocks:type_name -> spacemesh.v1.TortoiseBlock
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_spacemesh_v1_debug_types_proto_init() }
+func file_spacemesh_v1_debug_types_proto_init() {
+	if File_spacemesh_v1_debug_types_proto != nil {
+		return
+	}
+	file_spacemesh_v1_global_state_types_proto_init()
+	file_spacemesh_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_spacemesh_v1_debug_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_debug_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TortoiseLayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_debug_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TortoiseVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_debug_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TortoiseBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_debug_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TortoiseLayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spacemesh_v1_debug_types_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spacemesh_v1_debug_types_proto_goTypes,
+		DependencyIndexes: file_spacemesh_v1_debug_types_proto_depIdxs,
+		EnumInfos:         file_spacemesh_v1_debug_types_proto_enumTypes,
+		MessageInfos:      file_spacemesh_v1_debug_types_proto_msgTypes,
+	}.Build()
+	File_spacemesh_v1_debug_types_proto = out.File
+	file_spacemesh_v1_debug_types_proto_rawDesc = nil
+	file_spacemesh_v1_debug_types_proto_goTypes = nil
+	file_spacemesh_v1_debug_types_proto_depIdxs = nil
+}
@@ -0,0 +1,2457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: spacemesh/v1/mesh_types.proto
+
+package v1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type AccountMeshDataFlag int32
+
+const (
+	AccountMeshDataFlag_ACCOUNT_MESH_DATA_FLAG_UNSPECIFIED  AccountMeshDataFlag = 0
+	AccountMeshDataFlag_ACCOUNT_MESH_DATA_FLAG_TRANSACTIONS AccountMeshDataFlag = 1
+	AccountMeshDataFlag_ACCOUNT_MESH_DATA_FLAG_ACTIVATIONS  AccountMeshDataFlag = 2
+)
+
+// Enum value maps for AccountMeshDataFlag.
+var (
+	AccountMeshDataFlag_name = map[int32]string{
+		0: "ACCOUNT_MESH_DATA_FLAG_UNSPECIFIED",
+		1: "ACCOUNT_MESH_DATA_FLAG_TRANSACTIONS",
+		2: "ACCOUNT_MESH_DATA_FLAG_ACTIVATIONS",
+	}
+	AccountMeshDataFlag_value = map[string]int32{
+		"ACCOUNT_MESH_DATA_FLAG_UNSPECIFIED":  0,
+		"ACCOUNT_MESH_DATA_FLAG_TRANSACTIONS": 1,
+		"ACCOUNT_MESH_DATA_FLAG_ACTIVATIONS":  2,
+	}
+)
+
+func (x AccountMeshDataFlag) Enum() *AccountMeshDataFlag {
+	p := new(AccountMeshDataFlag)
+	*p = x
+	return p
+}
+
+func (x AccountMeshDataFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountMeshDataFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_spacemesh_v1_mesh_types_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountMeshDataFlag) Type() protoreflect.EnumType {
+	return &file_spacemesh_v1_mesh_types_proto_enumTypes[0]
+}
+
+func (x AccountMeshDataFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountMeshDataFlag.Descriptor instead.
+func (AccountMeshDataFlag) EnumDescriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{0}
+}
+
+type GenesisTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GenesisTimeRequest) Reset() {
+	*x = GenesisTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisTimeRequest) ProtoMessage() {}
+
+func (x *GenesisTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisTimeRequest.ProtoReflect.Descriptor instead.
+func (*GenesisTimeRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{0}
+}
+
+type GenesisTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Unixtime *SimpleInt `protobuf:"bytes,1,opt,name=unixtime,proto3" json:"unixtime,omitempty"`
+}
+
+func (x *GenesisTimeResponse) Reset() {
+	*x = GenesisTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisTimeResponse) ProtoMessage() {}
+
+func (x *GenesisTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisTimeResponse.ProtoReflect.Descriptor instead.
+func (*GenesisTimeResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisTimeResponse) GetUnixtime() *SimpleInt {
+	if x != nil {
+		return x.Unixtime
+	}
+	return nil
+}
+
+type CurrentLayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CurrentLayerRequest) Reset() {
+	*x = CurrentLayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentLayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentLayerRequest) ProtoMessage() {}
+
+func (x *CurrentLayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentLayerRequest.ProtoReflect.Descriptor instead.
+func (*CurrentLayerRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{2}
+}
+
+type CurrentLayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layernum *LayerNumber `protobuf:"bytes,1,opt,name=layernum,proto3" json:"layernum,omitempty"`
+}
+
+func (x *CurrentLayerResponse) Reset() {
+	*x = CurrentLayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentLayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentLayerResponse) ProtoMessage() {}
+
+func (x *CurrentLayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentLayerResponse.ProtoReflect.Descriptor instead.
+func (*CurrentLayerResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CurrentLayerResponse) GetLayernum() *LayerNumber {
+	if x != nil {
+		return x.Layernum
+	}
+	return nil
+}
+
+type CurrentEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CurrentEpochRequest) Reset() {
+	*x = CurrentEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentEpochRequest) ProtoMessage() {}
+
+func (x *CurrentEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentEpochRequest.ProtoReflect.Descriptor instead.
+func (*CurrentEpochRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{4}
+}
+
+type CurrentEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochnum *SimpleInt `protobuf:"bytes,1,opt,name=epochnum,proto3" json:"epochnum,omitempty"`
+}
+
+func (x *CurrentEpochResponse) Reset() {
+	*x = CurrentEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentEpochResponse) ProtoMessage() {}
+
+func (x *CurrentEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentEpochResponse.ProtoReflect.Descriptor instead.
+func (*CurrentEpochResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurrentEpochResponse) GetEpochnum() *SimpleInt {
+	if x != nil {
+		return x.Epochnum
+	}
+	return nil
+}
+
+type NetIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NetIDRequest) Reset() {
+	*x = NetIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetIDRequest) ProtoMessage() {}
+
+func (x *NetIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetIDRequest.ProtoReflect.Descriptor instead.
+func (*NetIDRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{6}
+}
+
+type NetIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Netid *SimpleInt `protobuf:"bytes,1,opt,name=netid,proto3" json:"netid,omitempty"`
+}
+
+func (x *NetIDResponse) Reset() {
+	*x = NetIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetIDResponse) ProtoMessage() {}
+
+func (x *NetIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetIDResponse.ProtoReflect.Descriptor instead.
+func (*NetIDResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NetIDResponse) GetNetid() *SimpleInt {
+	if x != nil {
+		return x.Netid
+	}
+	return nil
+}
+
+type EpochNumLayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EpochNumLayersRequest) Reset() {
+	*x = EpochNumLayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochNumLayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochNumLayersRequest) ProtoMessage() {}
+
+func (x *EpochNumLayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochNumLayersRequest.ProtoReflect.Descriptor instead.
+func (*EpochNumLayersRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{8}
+}
+
+type EpochNumLayersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numlayers *SimpleInt `protobuf:"bytes,1,opt,name=numlayers,proto3" json:"numlayers,omitempty"`
+}
+
+func (x *EpochNumLayersResponse) Reset() {
+	*x = EpochNumLayersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochNumLayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochNumLayersResponse) ProtoMessage() {}
+
+func (x *EpochNumLayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochNumLayersResponse.ProtoReflect.Descriptor instead.
+func (*EpochNumLayersResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EpochNumLayersResponse) GetNumlayers() *SimpleInt {
+	if x != nil {
+		return x.Numlayers
+	}
+	return nil
+}
+
+type LayerDurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LayerDurationRequest) Reset() {
+	*x = LayerDurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayerDurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayerDurationRequest) ProtoMessage() {}
+
+func (x *LayerDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayerDurationRequest.ProtoReflect.Descriptor instead.
+func (*LayerDurationRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{10}
+}
+
+type LayerDurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// layer duration, in seconds
+	Duration *SimpleInt `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *LayerDurationResponse) Reset() {
+	*x = LayerDurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayerDurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayerDurationResponse) ProtoMessage() {}
+
+func (x *LayerDurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayerDurationResponse.ProtoReflect.Descriptor instead.
+func (*LayerDurationResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LayerDurationResponse) GetDuration() *SimpleInt {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type MaxTransactionsPerSecondRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MaxTransactionsPerSecondRequest) Reset() {
+	*x = MaxTransactionsPerSecondRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaxTransactionsPerSecondRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxTransactionsPerSecondRequest) ProtoMessage() {}
+
+func (x *MaxTransactionsPerSecondRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxTransactionsPerSecondRequest.ProtoReflect.Descriptor instead.
+func (*MaxTransactionsPerSecondRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{12}
+}
+
+type MaxTransactionsPerSecondResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxTxsPerSecond *SimpleInt `protobuf:"bytes,1,opt,name=max_txs_per_second,json=maxTxsPerSecond,proto3" json:"max_txs_per_second,omitempty"`
+}
+
+func (x *MaxTransactionsPerSecondResponse) Reset() {
+	*x = MaxTransactionsPerSecondResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaxTransactionsPerSecondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxTransactionsPerSecondResponse) ProtoMessage() {}
+
+func (x *MaxTransactionsPerSecondResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxTransactionsPerSecondResponse.ProtoReflect.Descriptor instead.
+func (*MaxTransactionsPerSecondResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MaxTransactionsPerSecondResponse) GetMaxTxsPerSecond() *SimpleInt {
+	if x != nil {
+		return x.MaxTxsPerSecond
+	}
+	return nil
+}
+
+type AccountMeshDataFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId            *AccountId `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountMeshDataFlags uint32     `protobuf:"varint,2,opt,name=account_mesh_data_flags,json=accountMeshDataFlags,proto3" json:"account_mesh_data_flags,omitempty"` // A bit field of AccountMeshDataFlags
+}
+
+func (x *AccountMeshDataFilter) Reset() {
+	*x = AccountMeshDataFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshDataFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshDataFilter) ProtoMessage() {}
+
+func (x *AccountMeshDataFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshDataFilter.ProtoReflect.Descriptor instead.
+func (*AccountMeshDataFilter) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AccountMeshDataFilter) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *AccountMeshDataFilter) GetAccountMeshDataFlags() uint32 {
+	if x != nil {
+		return x.AccountMeshDataFlags
+	}
+	return 0
+}
+
+type AccountMeshData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Datum:
+	//	*AccountMeshData_Transaction
+	//	*AccountMeshData_Activation
+	Datum isAccountMeshData_Datum `protobuf_oneof:"datum"`
+}
+
+func (x *AccountMeshData) Reset() {
+	*x = AccountMeshData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshData) ProtoMessage() {}
+
+func (x *AccountMeshData) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshData.ProtoReflect.Descriptor instead.
+func (*AccountMeshData) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *AccountMeshData) GetDatum() isAccountMeshData_Datum {
+	if m != nil {
+		return m.Datum
+	}
+	return nil
+}
+
+func (x *AccountMeshData) GetTransaction() *Transaction {
+	if x, ok := x.GetDatum().(*AccountMeshData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *AccountMeshData) GetActivation() *Activation {
+	if x, ok := x.GetDatum().(*AccountMeshData_Activation); ok {
+		return x.Activation
+	}
+	return nil
+}
+
+type isAccountMeshData_Datum interface {
+	isAccountMeshData_Datum()
+}
+
+type AccountMeshData_Transaction struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3,oneof"`
+}
+
+type AccountMeshData_Activation struct {
+	Activation *Activation `protobuf:"bytes,2,opt,name=activation,proto3,oneof"`
+}
+
+func (*AccountMeshData_Transaction) isAccountMeshData_Datum() {}
+
+func (*AccountMeshData_Activation) isAccountMeshData_Datum() {}
+
+type AccountMeshDataStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *AccountMeshDataFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *AccountMeshDataStreamRequest) Reset() {
+	*x = AccountMeshDataStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshDataStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshDataStreamRequest) ProtoMessage() {}
+
+func (x *AccountMeshDataStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshDataStreamRequest.ProtoReflect.Descriptor instead.
+func (*AccountMeshDataStreamRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AccountMeshDataStreamRequest) GetFilter() *AccountMeshDataFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type AccountMeshDataStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datum *AccountMeshData `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+}
+
+func (x *AccountMeshDataStreamResponse) Reset() {
+	*x = AccountMeshDataStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshDataStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshDataStreamResponse) ProtoMessage() {}
+
+func (x *AccountMeshDataStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshDataStreamResponse.ProtoReflect.Descriptor instead.
+func (*AccountMeshDataStreamResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AccountMeshDataStreamResponse) GetDatum() *AccountMeshData {
+	if x != nil {
+		return x.Datum
+	}
+	return nil
+}
+
+type AccountMeshDataQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *AccountMeshDataFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	MinLayer   *LayerNumber           `protobuf:"bytes,2,opt,name=min_layer,json=minLayer,proto3" json:"min_layer,omitempty"`        // return data only from this layer or later
+	MaxResults uint32                 `protobuf:"varint,3,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"` // max number of results to return
+	Offset     uint32                 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                           // query offset
+}
+
+func (x *AccountMeshDataQueryRequest) Reset() {
+	*x = AccountMeshDataQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshDataQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshDataQueryRequest) ProtoMessage() {}
+
+func (x *AccountMeshDataQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshDataQueryRequest.ProtoReflect.Descriptor instead.
+func (*AccountMeshDataQueryRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountMeshDataQueryRequest) GetFilter() *AccountMeshDataFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AccountMeshDataQueryRequest) GetMinLayer() *LayerNumber {
+	if x != nil {
+		return x.MinLayer
+	}
+	return nil
+}
+
+func (x *AccountMeshDataQueryRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *AccountMeshDataQueryRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type AccountMeshDataQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         []*AccountMeshData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	TotalResults uint32             `protobuf:"varint,2,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"` // total number of availble results
+}
+
+func (x *AccountMeshDataQueryResponse) Reset() {
+	*x = AccountMeshDataQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMeshDataQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeshDataQueryResponse) ProtoMessage() {}
+
+func (x *AccountMeshDataQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeshDataQueryResponse.ProtoReflect.Descriptor instead.
+func (*AccountMeshDataQueryResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AccountMeshDataQueryResponse) GetData() []*AccountMeshData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AccountMeshDataQueryResponse) GetTotalResults() uint32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+type LayersQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartLayer *LayerNumber `protobuf:"bytes,1,opt,name=start_layer,json=startLayer,proto3" json:"start_layer,omitempty"`
+	EndLayer   *LayerNumber `protobuf:"bytes,2,opt,name=end_layer,json=endLayer,proto3" json:"end_layer,omitempty"`
+}
+
+func (x *LayersQueryRequest) Reset() {
+	*x = LayersQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayersQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayersQueryRequest) ProtoMessage() {}
+
+func (x *LayersQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayersQueryRequest.ProtoReflect.Descriptor instead.
+func (*LayersQueryRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LayersQueryRequest) GetStartLayer() *LayerNumber {
+	if x != nil {
+		return x.StartLayer
+	}
+	return nil
+}
+
+func (x *LayersQueryRequest) GetEndLayer() *LayerNumber {
+	if x != nil {
+		return x.EndLayer
+	}
+	return nil
+}
+
+type LayersQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer []*Layer `protobuf:"bytes,1,rep,name=layer,proto3" json:"layer,omitempty"`
+}
+
+func (x *LayersQueryResponse) Reset() {
+	*x = LayersQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayersQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayersQueryResponse) ProtoMessage() {}
+
+func (x *LayersQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayersQueryResponse.ProtoReflect.Descriptor instead.
+func (*LayersQueryResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LayersQueryResponse) GetLayer() []*Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+type LayerStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LayerStreamRequest) Reset() {
+	*x = LayerStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayerStreamRequest) ProtoMessage() {}
+
+func (x *LayerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayerStreamRequest.ProtoReflect.Descriptor instead.
+func (*LayerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{22}
+}
+
+type LayerStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer *Layer `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+}
+
+func (x *LayerStreamResponse) Reset() {
+	*x = LayerStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayerStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayerStreamResponse) ProtoMessage() {}
+
+func (x *LayerStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayerStreamResponse.ProtoReflect.Descriptor instead.
+func (*LayerStreamResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LayerStreamResponse) GetLayer() *Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+type SupplyStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer *LayerNumber `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"` // may be in the future
+}
+
+func (x *SupplyStatsRequest) Reset() {
+	*x = SupplyStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplyStatsRequest) ProtoMessage() {}
+
+func (x *SupplyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplyStatsRequest.ProtoReflect.Descriptor instead.
+func (*SupplyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SupplyStatsRequest) GetLayer() *LayerNumber {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+type SupplyStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer        *LayerNumber `protobuf:"bytes,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	Epoch        *SimpleInt   `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`                                   // the epoch of the layer
+	LayerReward  *Amount      `protobuf:"bytes,3,opt,name=layer_reward,json=layerReward,proto3" json:"layer_reward,omitempty"`    // the scheduled reward for the layer
+	EpochReward  *Amount      `protobuf:"bytes,4,opt,name=epoch_reward,json=epochReward,proto3" json:"epoch_reward,omitempty"`    // the scheduled total reward for the layer's epoch
+	Scheduled    *Amount      `protobuf:"bytes,5,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                           // the scheduled total reward for all layers up to and including the layer
+	Emitted      *Amount      `protobuf:"bytes,6,opt,name=emitted,proto3" json:"emitted,omitempty"`                               // the total layer reward actually paid out as of emitted_layer, less burned
+	EmittedLayer *LayerNumber `protobuf:"bytes,7,opt,name=emitted_layer,json=emittedLayer,proto3" json:"emitted_layer,omitempty"` // the layer, or the latest layer in state if it's earlier
+	Cap          *Amount      `protobuf:"bytes,8,opt,name=cap,proto3" json:"cap,omitempty"`                                       // unset if the supply isn't capped
+	Remaining    *Amount      `protobuf:"bytes,9,opt,name=remaining,proto3" json:"remaining,omitempty"`                           // the reward left to emit as of emitted_layer, unset if the supply isn't capped
+	Burned       *Amount      `protobuf:"bytes,10,opt,name=burned,proto3" json:"burned,omitempty"`                                // the total reward remainder burned as of emitted_layer
+}
+
+func (x *SupplyStatsResponse) Reset() {
+	*x = SupplyStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplyStatsResponse) ProtoMessage() {}
+
+func (x *SupplyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplyStatsResponse.ProtoReflect.Descriptor instead.
+func (*SupplyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SupplyStatsResponse) GetLayer() *LayerNumber {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetEpoch() *SimpleInt {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetLayerReward() *Amount {
+	if x != nil {
+		return x.LayerReward
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetEpochReward() *Amount {
+	if x != nil {
+		return x.EpochReward
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetScheduled() *Amount {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetEmitted() *Amount {
+	if x != nil {
+		return x.Emitted
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetEmittedLayer() *LayerNumber {
+	if x != nil {
+		return x.EmittedLayer
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetCap() *Amount {
+	if x != nil {
+		return x.Cap
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetRemaining() *Amount {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *SupplyStatsResponse) GetBurned() *Amount {
+	if x != nil {
+		return x.Burned
+	}
+	return nil
+}
+
+type TransactionBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *TransactionId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TransactionBlocksRequest) Reset() {
+	*x = TransactionBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionBlocksRequest) ProtoMessage() {}
+
+func (x *TransactionBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionBlocksRequest.ProtoReflect.Descriptor instead.
+func (*TransactionBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TransactionBlocksRequest) GetId() *TransactionId {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type TransactionBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer []*Layer `protobuf:"bytes,1,rep,name=layer,proto3" json:"layer,omitempty"` // the blocks that include the transaction, grouped by layer
+}
+
+func (x *TransactionBlocksResponse) Reset() {
+	*x = TransactionBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionBlocksResponse) ProtoMessage() {}
+
+func (x *TransactionBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionBlocksResponse.ProtoReflect.Descriptor instead.
+func (*TransactionBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransactionBlocksResponse) GetLayer() []*Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+type SmesherBlocksQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SmesherId  *SmesherId `protobuf:"bytes,1,opt,name=smesher_id,json=smesherId,proto3" json:"smesher_id,omitempty"`
+	MaxResults uint32     `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"` // Return up to this many results
+	Offset     uint32     `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                           // Page offset, in blocks
+}
+
+func (x *SmesherBlocksQueryRequest) Reset() {
+	*x = SmesherBlocksQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmesherBlocksQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmesherBlocksQueryRequest) ProtoMessage() {}
+
+func (x *SmesherBlocksQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmesherBlocksQueryRequest.ProtoReflect.Descriptor instead.
+func (*SmesherBlocksQueryRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SmesherBlocksQueryRequest) GetSmesherId() *SmesherId {
+	if x != nil {
+		return x.SmesherId
+	}
+	return nil
+}
+
+func (x *SmesherBlocksQueryRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *SmesherBlocksQueryRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SmesherBlocksQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layer        []*Layer `protobuf:"bytes,1,rep,name=layer,proto3" json:"layer,omitempty"`                                    // the blocks produced by the smesher, grouped by layer
+	TotalResults uint32   `protobuf:"varint,2,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"` // the number of blocks produced by the smesher
+}
+
+func (x *SmesherBlocksQueryResponse) Reset() {
+	*x = SmesherBlocksQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmesherBlocksQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmesherBlocksQueryResponse) ProtoMessage() {}
+
+func (x *SmesherBlocksQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmesherBlocksQueryResponse.ProtoReflect.Descriptor instead.
+func (*SmesherBlocksQueryResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SmesherBlocksQueryResponse) GetLayer() []*Layer {
+	if x != nil {
+		return x.Layer
+	}
+	return nil
+}
+
+func (x *SmesherBlocksQueryResponse) GetTotalResults() uint32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+type CoinbaseActivationsQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId  *AccountId   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`     // the coinbase
+	MinLayer   *LayerNumber `protobuf:"bytes,2,opt,name=min_layer,json=minLayer,proto3" json:"min_layer,omitempty"`        // Return activations published at or after this layer
+	MaxResults uint32       `protobuf:"varint,3,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"` // Return up to this many results
+	Offset     uint32       `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                           // Page offset
+}
+
+func (x *CoinbaseActivationsQueryRequest) Reset() {
+	*x = CoinbaseActivationsQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinbaseActivationsQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinbaseActivationsQueryRequest) ProtoMessage() {}
+
+func (x *CoinbaseActivationsQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinbaseActivationsQueryRequest.ProtoReflect.Descriptor instead.
+func (*CoinbaseActivationsQueryRequest) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CoinbaseActivationsQueryRequest) GetAccountId() *AccountId {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *CoinbaseActivationsQueryRequest) GetMinLayer() *LayerNumber {
+	if x != nil {
+		return x.MinLayer
+	}
+	return nil
+}
+
+func (x *CoinbaseActivationsQueryRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *CoinbaseActivationsQueryRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CoinbaseActivationsQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activation   []*Activation `protobuf:"bytes,1,rep,name=activation,proto3" json:"activation,omitempty"`
+	TotalResults uint32        `protobuf:"varint,2,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"` // the number of matching activations
+}
+
+func (x *CoinbaseActivationsQueryResponse) Reset() {
+	*x = CoinbaseActivationsQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinbaseActivationsQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinbaseActivationsQueryResponse) ProtoMessage() {}
+
+func (x *CoinbaseActivationsQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spacemesh_v1_mesh_types_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinbaseActivationsQueryResponse.ProtoReflect.Descriptor instead.
+func (*CoinbaseActivationsQueryResponse) Descriptor() ([]byte, []int) {
+	return file_spacemesh_v1_mesh_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CoinbaseActivationsQueryResponse) GetActivation() []*Activation {
+	if x != nil {
+		return x.Activation
+	}
+	return nil
+}
+
+func (x *CoinbaseActivationsQueryResponse) GetTotalResults() uint32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+var File_spacemesh_v1_mesh_types_proto protoreflect.FileDescriptor
+
+var file_spacemesh_v1_mesh_types_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a,
+	0x13, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52,
+	0x08, 0x75, 0x6e, 0x69, 0x78, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4d, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x6e, 0x75, 0x6d, 0x22,
+	0x15, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x6e, 0x75, 0x6d, 0x22, 0x0e, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x6e, 0x65,
+	0x74, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x16,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49,
+	0x6e, 0x74, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x16, 0x0a,
+	0x14, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x20, 0x4d, 0x61, 0x78, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x22, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75,
+	0x6d, 0x22, 0x5b, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x54,
+	0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x75, 0x6d, 0x22, 0xcb, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x76, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x09, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a,
+	0x13, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
+	0x45, 0x0a, 0x12, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x95, 0x04, 0x0a, 0x13, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x37,
+	0x0a, 0x0c, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x32, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x32, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x2c, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x22, 0x47,
+	0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
+	0x8c, 0x01, 0x0a, 0x19, 0x53, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x0a, 0x73, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x72, 0x49, 0x64, 0x52, 0x09, 0x73, 0x6d, 0x65, 0x73,
+	0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6c,
+	0x0a, 0x1a, 0x53, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xca, 0x01, 0x0a,
+	0x1f, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x20, 0x43, 0x6f,
+	0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x8e, 0x01,
+	0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x44, 0x61, 0x74,
+	0x61, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x4d, 0x45, 0x53, 0x48, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a,
+	0x23, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x48, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x4d, 0x45, 0x53, 0x48, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x4c, 0x41, 0x47,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x42, 0x34,
+	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x73, 0x68, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spacemesh_v1_mesh_types_proto_rawDescOnce sync.Once
+	file_spacemesh_v1_mesh_types_proto_rawDescData = file_spacemesh_v1_mesh_types_proto_rawDesc
+)
+
+func file_spacemesh_v1_mesh_types_proto_rawDescGZIP() []byte {
+	file_spacemesh_v1_mesh_types_proto_rawDescOnce.Do(func() {
+		file_spacemesh_v1_mesh_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_spacemesh_v1_mesh_types_proto_rawDescData)
+	})
+	return file_spacemesh_v1_mesh_types_proto_rawDescData
+}
+
+var file_spacemesh_v1_mesh_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_spacemesh_v1_mesh_types_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_spacemesh_v1_mesh_types_proto_goTypes = []interface{}{
+	(AccountMeshDataFlag)(0),                 // 0: spacemesh.v1.AccountMeshDataFlag
+	(*GenesisTimeRequest)(nil),               // 1: spacemesh.v1.GenesisTimeRequest
+	(*GenesisTimeResponse)(nil),              // 2: spacemesh.v1.GenesisTimeResponse
+	(*CurrentLayerRequest)(nil),              // 3: spacemesh.v1.CurrentLayerRequest
+	(*CurrentLayerResponse)(nil),             // 4: spacemesh.v1.CurrentLayerResponse
+	(*CurrentEpochRequest)(nil),              // 5: spacemesh.v1.CurrentEpochRequest
+	(*CurrentEpochResponse)(nil),             // 6: spacemesh.v1.CurrentEpochResponse
+	(*NetIDRequest)(nil),                     // 7: spacemesh.v1.NetIDRequest
+	(*NetIDResponse)(nil),                    // 8: spacemesh.v1.NetIDResponse
+	(*EpochNumLayersRequest)(nil),            // 9: spacemesh.v1.EpochNumLayersRequest
+	(*EpochNumLayersResponse)(nil),           // 10: spacemesh.v1.EpochNumLayersResponse
+	(*LayerDurationRequest)(nil),             // 11: spacemesh.v1.LayerDurationRequest
+	(*LayerDurationResponse)(nil),            // 12: spacemesh.v1.LayerDurationResponse
+	(*MaxTransactionsPerSecondRequest)(nil),  // 13: spacemesh.v1.MaxTransactionsPerSecondRequest
+	(*MaxTransactionsPerSecondResponse)(nil), // 14: spacemesh.v1.MaxTransactionsPerSecondResponse
+	(*AccountMeshDataFilter)(nil),            // 15: spacemesh.v1.AccountMeshDataFilter
+	(*AccountMeshData)(nil),                  // 16: spacemesh.v1.AccountMeshData
+	(*AccountMeshDataStreamRequest)(nil),     // 17: spacemesh.v1.AccountMeshDataStreamRequest
+	(*AccountMeshDataStreamResponse)(nil),    // 18: spacemesh.v1.AccountMeshDataStreamResponse
+	(*AccountMeshDataQueryRequest)(nil),      // 19: spacemesh.v1.AccountMeshDataQueryRequest
+	(*AccountMeshDataQueryResponse)(nil),     // 20: spacemesh.v1.AccountMeshDataQueryResponse
+	(*LayersQueryRequest)(nil),               // 21: spacemesh.v1.LayersQueryRequest
+	(*LayersQueryResponse)(nil),              // 22: spacemesh.v1.LayersQueryResponse
+	(*LayerStreamRequest)(nil),               // 23: spacemesh.v1.LayerStreamRequest
+	(*LayerStreamResponse)(nil),              // 24: spacemesh.v1.LayerStreamResponse
+	(*SupplyStatsRequest)(nil),               // 25: spacemesh.v1.SupplyStatsRequest
+	(*SupplyStatsResponse)(nil),              // 26: spacemesh.v1.SupplyStatsResponse
+	(*TransactionBlocksRequest)(nil),         // 27: spacemesh.v1.TransactionBlocksRequest
+	(*TransactionBlocksResponse)(nil),        // 28: spacemesh.v1.TransactionBlocksResponse
+	(*SmesherBlocksQueryRequest)(nil),        // 29: spacemesh.v1.SmesherBlocksQueryRequest
+	(*SmesherBlocksQueryResponse)(nil),       // 30: spacemesh.v1.SmesherBlocksQueryResponse
+	(*CoinbaseActivationsQueryRequest)(nil),  // 31: spacemesh.v1.CoinbaseActivationsQueryRequest
+	(*CoinbaseActivationsQueryResponse)(nil), // 32: spacemesh.v1.CoinbaseActivationsQueryResponse
+	(*SimpleInt)(nil),                        // 33: spacemesh.v1.SimpleInt
+	(*LayerNumber)(nil),                      // 34: spacemesh.v1.LayerNumber
+	(*AccountId)(nil),                        // 35: spacemesh.v1.AccountId
+	(*Transaction)(nil),                      // 36: spacemesh.v1.Transaction
+	(*Activation)(nil),                       // 37: spacemesh.v1.Activation
+	(*Layer)(nil),                            // 38: spacemesh.v1.Layer
+	(*Amount)(nil),                           // 39: spacemesh.v1.Amount
+	(*TransactionId)(nil),                    // 40: spacemesh.v1.TransactionId
+	(*SmesherId)(nil),                        // 41: spacemesh.v1.SmesherId
+}
+var file_spacemesh_v1_mesh_types_proto_depIdxs = []int32{
+	33, // 0: spacemesh.v1.GenesisTimeResponse.unixtime:type_name -> spacemesh.v1.SimpleInt
+	34, // 1: spacemesh.v1.CurrentLayerResponse.layernum:type_name -> spacemesh.v1.LayerNumber
+	33, // 2: spacemesh.v1.CurrentEpochResponse.epochnum:type_name -> spacemesh.v1.SimpleInt
+	33, // 3: spacemesh.v1.NetIDResponse.netid:type_name -> spacemesh.v1.SimpleInt
+	33, // 4: spacemesh.v1.EpochNumLayersResponse.numlayers:type_name -> spacemesh.v1.SimpleInt
+	33, // 5: spacemesh.v1.LayerDurationResponse.duration:type_name -> spacemesh.v1.SimpleInt
+	33, // 6: spacemesh.v1.MaxTransactionsPerSecondResponse.max_txs_per_second:type_name -> spacemesh.v1.SimpleInt
+	35, // 7: spacemesh.v1.AccountMeshDataFilter.account_id:type_name -> spacemesh.v1.AccountId
+	36, // 8: spacemesh.v1.AccountMeshData.transaction:type_name -> spacemesh.v1.Transaction
+	37, // 9: spacemesh.v1.AccountMeshData.activation:type_name -> spacemesh.v1.Activation
+	15, // 10: spacemesh.v1.AccountMeshDataStreamRequest.filter:type_name -> spacemesh.v1.AccountMeshDataFilter
+	16, // 11: spacemesh.v1.AccountMeshDataStreamResponse.datum:type_name -> spacemesh.v1.AccountMeshData
+	15, // 12: spacemesh.v1.AccountMeshDataQueryRequest.filter:type_name -> spacemesh.v1.AccountMeshDataFilter
+	34, // 13: spacemesh.v1.AccountMeshDataQueryRequest.min_layer:type_name -> spacemesh.v1.LayerNumber
+	16, // 14: spacemesh.v1.AccountMeshDataQueryResponse.data:type_name -> spacemesh.v1.AccountMeshData
+	34, // 15: spacemesh.v1.LayersQueryRequest.start_layer:type_name -> spacemesh.v1.LayerNumber
+	34, // 16: spacemesh.v1.LayersQueryRequest.end_layer:type_name -> spacemesh.v1.LayerNumber
+	38, // 17: spacemesh.v1.LayersQueryResponse.layer:type_name -> spacemesh.v1.Layer
+	38, // 18: spacemesh.v1.LayerStreamResponse.layer:type_name -> spacemesh.v1.Layer
+	34, // 19: spacemesh.v1.SupplyStatsRequest.layer:type_name -> spacemesh.v1.LayerNumber
+	34, // 20: spacemesh.v1.SupplyStatsResponse.layer:type_name -> spacemesh.v1.LayerNumber
+	33, // 21: spacemesh.v1.SupplyStatsResponse.epoch:type_name -> spacemesh.v1.SimpleInt
+	39, // 22: spacemesh.v1.SupplyStatsResponse.layer_reward:type_name -> spacemesh.v1.Amount
+	39, // 23: spacemesh.v1.SupplyStatsResponse.epoch_reward:type_name -> spacemesh.v1.Amount
+	39, // 24: spacemesh.v1.SupplyStatsResponse.scheduled:type_name -> spacemesh.v1.Amount
+	39, // 25: spacemesh.v1.SupplyStatsResponse.emitted:type_name -> spacemesh.v1.Amount
+	34, // 26: spacemesh.v1.SupplyStatsResponse.emitted_layer:type_name -> spacemesh.v1.LayerNumber
+	39, // 27: spacemesh.v1.SupplyStatsResponse.cap:type_name -> spacemesh.v1.Amount
+	39, // 28: spacemesh.v1.SupplyStatsResponse.remaining:type_name -> spacemesh.v1.Amount
+	39, // 29: spacemesh.v1.SupplyStatsResponse.burned:type_name -> spacemesh.v1.Amount
+	40, // 30: spacemesh.v1.TransactionBlocksRequest.id:type_name -> spacemesh.v1.TransactionId
+	38, // 31: spacemesh.v1.TransactionBlocksResponse.layer:type_name -> spacemesh.v1.Layer
+	41, // 32: spacemesh.v1.SmesherBlocksQueryRequest.smesher_id:type_name -> spacemesh.v1.SmesherId
+	38, // 33: spacemesh.v1.SmesherBlocksQueryResponse.layer:type_name -> spacemesh.v1.Layer
+	35, // 34: spacemesh.v1.CoinbaseActivationsQueryRequest.account_id:type_name -> spacemesh.v1.AccountId
+	34, // 35: spacemesh.v1.CoinbaseActivationsQueryRequest.min_layer:type_name -> spacemesh.v1.LayerNumber
+	37, // 36: spacemesh.v1.CoinbaseActivationsQueryResponse.activation:type_name -> spacemesh.v1.Activation
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_spacemesh_v1_mesh_types_proto_init() }
+func file_spacemesh_v1_mesh_types_proto_init() {
+	if File_spacemesh_v1_mesh_types_proto != nil {
+		return
+	}
+	file_spacemesh_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_spacemesh_v1_mesh_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisTimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentLayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentLayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentEpochResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochNumLayersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochNumLayersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerDurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerDurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaxTransactionsPerSecondRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaxTransactionsPerSecondResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshDataFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshDataStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshDataStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshDataQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountMeshDataQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayersQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayersQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplyStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplyStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmesherBlocksQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmesherBlocksQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinbaseActivationsQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spacemesh_v1_mesh_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinbaseActivationsQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_spacemesh_v1_mesh_types_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*AccountMeshData_Transaction)(nil),
+		(*AccountMeshData_Activation)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spacemesh_v1_mesh_types_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spacemesh_v1_mesh_types_proto_goTypes,
+		DependencyIndexes: file_spacemesh_v1_mesh_types_proto_depIdxs,
+		EnumInfos:         file_spacemesh_v1_mesh_types_proto_enumTypes,
+		MessageInfos:      file_spacemesh_v1_mesh_types_proto_msgTypes,
+	}.Build()
+	File_spacemesh_v1_mesh_types_proto = out.File
+	file_spacemesh_v1_mesh_types_proto_rawDesc = nil
+	file_spacemesh_v1_mesh_types_proto_goTypes = nil
+	file_spacemesh_v1_mesh_types_proto_depIdxs = nil
+}
@@ -0,0 +1,175 @@
+package tortoise
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+)
+
+// replaySource is the mesh whose layers are replayed. It's only read.
+type replaySource interface {
+	GetBlock(id types.BlockID) (*types.Block, error)
+	LayerBlockIds(l types.LayerID) ([]types.BlockID, error)
+	GetLayerInputVector(lyrid types.LayerID) ([]types.BlockID, error)
+	ContextualValidity(id types.BlockID) (bool, error)
+	PrunedLayer() types.LayerID
+}
+
+// replayProvider serves the blocks of the replayed mesh to the tortoise, and keeps what the tortoise writes in memory,
+// so the mesh isn't changed.
+type replayProvider struct {
+	replaySource
+	validity      map[types.BlockID]bool
+	healedVectors map[types.LayerID][]types.BlockID
+}
+
+// GetLayerHealedVector returns the valid blocks of the layers the replayed tortoise verified with the full tortoise, and
+// not the ones the mesh's tortoise did, which the replay recomputes.
+func (p *replayProvider) GetLayerHealedVector(lyrid types.LayerID) ([]types.BlockID, error) {
+	if v, ok := p.healedVectors[lyrid]; ok {
+		return v, nil
+	}
+	return nil, database.ErrNotFound
+}
+
+func (p *replayProvider) SaveLayerHealedVector(lyrid types.LayerID, vector []types.BlockID) error {
+	p.healedVectors[lyrid] = vector
+	return nil
+}
+
+func (p *replayProvider) SaveContextualValidity(id types.BlockID, valid bool) error {
+	p.validity[id] = valid
+	return nil
+}
+
+func (p *replayProvider) PersistBatch(keys [][]byte, values []interface{}) error {
+	return nil
+}
+
+func (p *replayProvider) Retrieve(key []byte, v interface{}) (interface{}, error) {
+	return nil, errors.New("the replayed tortoise isn't persisted")
+}
+
+// ReplayConfig holds the layers to replay and the tortoise parameters to replay them with.
+type ReplayConfig struct {
+	From                 types.LayerID // the first layer whose validity is compared
+	To                   types.LayerID // the last layer that is replayed
+	Hdist                int
+	AvgLayerSize         int
+	FullTortoiseDistance int
+	AtxDB                atxDataProvider
+	Log                  log.Log
+}
+
+// Validity strings of the blocks compared by Replay.
+const (
+	ValidityValid       = "valid"
+	ValidityInvalid     = "invalid"
+	ValidityMissing     = "missing"      // the mesh has no validity for the block
+	ValidityNotVerified = "not verified" // the replayed tortoise didn't verify the block's layer
+)
+
+// ValidityDiff is a block whose validity after the replay differs from its validity in the mesh.
+type ValidityDiff struct {
+	Block    types.BlockID
+	Layer    types.LayerID
+	Stored   string
+	Replayed string
+}
+
+func (d ValidityDiff) String() string {
+	return fmt.Sprintf("layer %v block %v: stored %v, replayed %v", d.Layer, d.Block, d.Stored, d.Replayed)
+}
+
+// ReplayResult is the outcome of a replay.
+type ReplayResult struct {
+	Verified types.LayerID // the last layer verified by the replayed tortoise
+	Compared int           // the number of blocks whose validity was compared
+	Diffs    []ValidityDiff
+}
+
+// Replay feeds the layers of a mesh, from the first layer after the effective genesis up to cfg.To, to a new verifying
+// tortoise in memory with the given parameters, along with their input vectors, the way the node does. It then
+// compares the validity of the blocks of layers cfg.From to cfg.To with the validity stored in the mesh. The mesh
+// isn't changed. A mesh whose old layers were pruned can't be replayed, since the tortoise needs the blocks of all the
+// layers after the effective genesis.
+func Replay(src replaySource, cfg ReplayConfig) (*ReplayResult, error) {
+	if pruned := src.PrunedLayer(); pruned > 0 {
+		return nil, fmt.Errorf("blocks of layers up to %v were pruned, the mesh can only be replayed if the node keeps all layers (layer-retention 0)", pruned)
+	}
+	if cfg.From <= types.GetEffectiveGenesis() {
+		cfg.From = types.GetEffectiveGenesis() + 1
+	}
+	if cfg.To < cfg.From {
+		return nil, fmt.Errorf("no layers to replay between %v and %v", cfg.From, cfg.To)
+	}
+	provider := &replayProvider{
+		replaySource:  src,
+		validity:      make(map[types.BlockID]bool),
+		healedVectors: make(map[types.LayerID][]types.BlockID),
+	}
+	trtl := newTurtle(provider, cfg.Hdist, cfg.AvgLayerSize)
+	trtl.SetLogger(cfg.Log)
+	trtl.fullTortoiseDistance = types.LayerID(cfg.FullTortoiseDistance)
+	trtl.atxdb = cfg.AtxDB
+	trtl.init(mesh.GenesisLayer())
+
+	for l := types.GetEffectiveGenesis() + 1; l <= cfg.To; l++ {
+		ids, err := src.LayerBlockIds(l)
+		if err != nil {
+			return nil, fmt.Errorf("can't read the blocks of layer %v: %v", l, err)
+		}
+		lyr := types.NewLayer(l)
+		for _, id := range ids {
+			blk, err := src.GetBlock(id)
+			if err != nil {
+				return nil, fmt.Errorf("can't read block %v of layer %v: %v", id, l, err)
+			}
+			lyr.AddBlock(blk)
+		}
+		input, err := src.GetLayerInputVector(l)
+		if err != nil {
+			// the hare didn't finish, the input vector abstains on the layer's blocks
+			input = nil
+		}
+		trtl.HandleIncomingLayer(lyr, input)
+	}
+
+	res := &ReplayResult{Verified: trtl.Verified}
+	for l := cfg.From; l <= cfg.To; l++ {
+		ids, err := src.LayerBlockIds(l)
+		if err != nil {
+			return nil, fmt.Errorf("can't read the blocks of layer %v: %v", l, err)
+		}
+		for _, id := range types.SortBlockIDs(ids) {
+			stored := ValidityMissing
+			if valid, err := src.ContextualValidity(id); err == nil {
+				stored = validityString(valid)
+			}
+			replayed := ValidityNotVerified
+			if valid, ok := provider.validity[id]; ok && l <= trtl.Verified {
+				replayed = validityString(valid)
+			}
+			res.Compared++
+			// neither tortoise decided on the block
+			if stored == ValidityMissing && replayed == ValidityNotVerified {
+				continue
+			}
+			if stored != replayed {
+				res.Diffs = append(res.Diffs, ValidityDiff{Block: id, Layer: l, Stored: stored, Replayed: replayed})
+			}
+		}
+	}
+	return res, nil
+}
+
+func validityString(valid bool) string {
+	if valid {
+		return ValidityValid
+	}
+	return ValidityInvalid
+}
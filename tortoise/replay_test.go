@@ -0,0 +1,98 @@
+package tortoise
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/log"
+	"github.com/spacemeshos/go-spacemesh/mesh"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	const blocksPerLayer = 10
+	last := types.GetEffectiveGenesis() + 10
+	// the hare never finished this layer, so the node's tortoise stalled before it
+	failed := types.GetEffectiveGenesis() + 4
+
+	msh := getInMemMesh()
+	trtl := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+	trtl.init(mesh.GenesisLayer())
+	for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+		b, lists, err := trtl.BaseBlock()
+		require.NoError(t, err)
+		lyr := types.NewLayer(l)
+		for i := 0; i < blocksPerLayer; i++ {
+			blk := types.NewExistingBlock(l, []byte(strconv.Itoa(i)), nil)
+			blk.BaseBlock = b
+			blk.AgainstDiff = lists[0]
+			blk.ForDiff = lists[1]
+			blk.NeutralDiff = lists[2]
+			blk.Initialize()
+			lyr.AddBlock(blk)
+			require.NoError(t, msh.AddBlock(blk))
+		}
+		var input []types.BlockID
+		if l != failed {
+			input = types.BlockIDs(lyr.Blocks())
+			require.NoError(t, msh.SaveLayerInputVector(l, input))
+		}
+		trtl.HandleIncomingLayer(lyr, input)
+	}
+	require.Equal(t, int(failed-1), int(trtl.Verified))
+
+	cfg := ReplayConfig{
+		To:           last,
+		Hdist:        defaultTestHdist,
+		AvgLayerSize: blocksPerLayer,
+		Log:          log.NewDefault(t.Name()),
+	}
+	res, err := Replay(msh, cfg)
+	require.NoError(t, err)
+	require.Equal(t, trtl.Verified, res.Verified)
+	require.Equal(t, blocksPerLayer*int(last-types.GetEffectiveGenesis()), res.Compared)
+	require.Empty(t, res.Diffs)
+
+	// a block whose stored validity doesn't match the replayed one is reported
+	ids, err := msh.LayerBlockIds(failed - 1)
+	require.NoError(t, err)
+	changed := types.SortBlockIDs(ids)[0]
+	require.NoError(t, msh.SaveContextualValidity(changed, false))
+	cfg.From = failed - 1
+	res, err = Replay(msh, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []ValidityDiff{{Block: changed, Layer: failed - 1, Stored: ValidityInvalid, Replayed: ValidityValid}}, res.Diffs)
+	require.NoError(t, msh.SaveContextualValidity(changed, true))
+
+	// with the full tortoise the replayed tortoise verifies the layers the node's tortoise didn't, without writing to
+	// the mesh. None of the blocks made by the stalled tortoise are good, so the full tortoise verifies all the layers
+	// after the failed one, as they fall behind enough.
+	cfg.FullTortoiseDistance = 3
+	res, err = Replay(msh, cfg)
+	require.NoError(t, err)
+	require.Equal(t, int(last-4), int(res.Verified))
+	require.Len(t, res.Diffs, blocksPerLayer*int(res.Verified-failed+1))
+	for _, diff := range res.Diffs {
+		require.Equal(t, ValidityMissing, diff.Stored)
+		require.NotEqual(t, ValidityNotVerified, diff.Replayed)
+	}
+	_, err = msh.GetLayerInputVector(failed)
+	require.Error(t, err)
+	_, err = msh.ContextualValidity(res.Diffs[0].Block)
+	require.Error(t, err)
+
+	// a mesh whose old layers were pruned can't be replayed from the effective genesis
+	_, err = Replay(prunedMesh{DB: msh, pruned: failed}, cfg)
+	require.EqualError(t, err, fmt.Sprintf("blocks of layers up to %v were pruned, the mesh can only be replayed if the node keeps all layers (layer-retention 0)", failed))
+}
+
+type prunedMesh struct {
+	*mesh.DB
+	pruned types.LayerID
+}
+
+func (m prunedMesh) PrunedLayer() types.LayerID {
+	return m.pruned
+}
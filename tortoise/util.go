@@ -76,8 +76,9 @@ func (a vec) String() string {
 	return "abstain"
 }
 
-func globalOpinion(v vec, layerSize int, delta float64) vec {
-	threshold := float64(globalThreshold*delta) * float64(layerSize)
+// globalOpinion returns the opinion of the votes v if they exceed the threshold of the expected weight of delta layers
+func globalOpinion(v vec, layerWeight int, delta float64) vec {
+	threshold := float64(globalThreshold*delta) * float64(layerWeight)
 	if float64(v[0]) > threshold {
 		return support
 	} else if float64(v[1]) > threshold {
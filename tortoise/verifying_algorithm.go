@@ -21,14 +21,22 @@ type Config struct {
 	Hdist     int
 	Log       log.Log
 	Recovered bool
+
+	FullTortoiseDistance int             // layers a layer must be behind the last layer to be verified by the full tortoise, 0 to disable it
+	AtxDB                atxDataProvider // weighs the votes of the blocks by their ATXs, all blocks weigh the same if nil
 }
 
 // NewVerifyingTortoise creates a new verifying tortoise wrapper
 func NewVerifyingTortoise(cfg Config) *ThreadSafeVerifyingTortoise {
+	var alg *ThreadSafeVerifyingTortoise
 	if cfg.Recovered {
-		return recoveredVerifyingTortoise(cfg.Database, cfg.Log)
+		alg = recoveredVerifyingTortoise(cfg.Database, cfg.Log)
+	} else {
+		alg = verifyingTortoise(cfg.LayerSyze, cfg.Database, cfg.Hdist, cfg.Log)
 	}
-	return verifyingTortoise(cfg.LayerSyze, cfg.Database, cfg.Hdist, cfg.Log)
+	alg.trtl.fullTortoiseDistance = types.LayerID(cfg.FullTortoiseDistance)
+	alg.trtl.atxdb = cfg.AtxDB
+	return alg
 }
 
 // verifyingTortoise creates a new verifying tortoise wrapper
@@ -103,3 +111,10 @@ func (trtl *ThreadSafeVerifyingTortoise) Persist() error {
 	log.Info("persist tortoise ")
 	return trtl.trtl.persist()
 }
+
+// InspectLayer returns the tortoise's view of a layer, its blocks' votes and why it isn't verified.
+func (trtl *ThreadSafeVerifyingTortoise) InspectLayer(layer types.LayerID) (*types.LayerInspection, error) {
+	trtl.mutex.RLock()
+	defer trtl.mutex.RUnlock()
+	return trtl.trtl.inspectLayer(layer)
+}
@@ -3,10 +3,14 @@ package tortoise
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/bits"
+
 	"github.com/spacemeshos/go-spacemesh/common/types"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/mesh"
 	"github.com/syndtr/goleveldb/leveldb"
+	"sort"
 )
 
 type blockDataProvider interface {
@@ -14,14 +18,20 @@ type blockDataProvider interface {
 	LayerBlockIds(l types.LayerID) (ids []types.BlockID, err error)
 
 	GetLayerInputVector(lyrid types.LayerID) ([]types.BlockID, error)
-	SaveLayerInputVector(lyrid types.LayerID, vector []types.BlockID) error
+	GetLayerHealedVector(lyrid types.LayerID) ([]types.BlockID, error)
+	SaveLayerHealedVector(lyrid types.LayerID, vector []types.BlockID) error
 
 	SaveContextualValidity(id types.BlockID, valid bool) error
 
-	Persist(key []byte, v interface{}) error
+	PersistBatch(keys [][]byte, values []interface{}) error
 	Retrieve(key []byte, v interface{}) (interface{}, error)
 }
 
+type atxDataProvider interface {
+	GetAtxHeader(id types.ATXID) (*types.ActivationTxHeader, error)
+	GetEpochWeight(epochID types.EpochID) (uint64, []types.ATXID, error)
+}
+
 func blockMapToArray(m map[types.BlockID]struct{}) []types.BlockID {
 	arr := make([]types.BlockID, len(m))
 	i := 0
@@ -55,6 +65,51 @@ type turtle struct {
 
 	// TODO: Tal says - We keep a vector containing our vote totals (positive and negative) for every previous block
 	//	that's not needed here, probably for self healing?
+
+	// fullTortoiseDistance is the number of layers a layer must be behind the last layer before the full tortoise
+	// verifies it when the verifying tortoise can't, 0 disables the full tortoise. It isn't persisted, it's set from
+	// the config when the tortoise is recovered.
+	fullTortoiseDistance types.LayerID
+
+	// atxdb provides the weight of the blocks' ATXs. Without it all blocks weigh the same.
+	atxdb atxDataProvider
+	// the average weight of the ATXs of the blocks of every epoch, the weights of those ATXs and the weights of the
+	// blocks of every layer. Only complete results are cached, since missing ATXs and blocks may still arrive. They
+	// aren't persisted, they're computed again after recovery.
+	epochWeights map[types.EpochID]epochWeight
+	atxWeights   map[types.EpochID]map[types.ATXID]uint64
+	blockWeights map[types.LayerID]map[types.BlockID]int
+	// the weights of the blocks of the layers whose blocks or ATXs aren't all known, computed once while a layer is
+	// handled, and computed again when the next layer is handled, since the missing ones may have arrived by then
+	incompleteWeights map[types.LayerID]map[types.BlockID]int
+
+	// the layers whose blocks changed since the tortoise was last persisted, and the first layer that wasn't evicted
+	// then. Only the changes are written when the tortoise is persisted.
+	dirty          map[types.LayerID]struct{}
+	persistedEvict types.LayerID
+	// legacy is set when the tortoise was recovered from the whole turtle persisted by an earlier version, which is
+	// deleted when the tortoise is persisted again.
+	legacy bool
+}
+
+// turtleHeader is the persisted state of the turtle. The state of its layers is persisted separately, so that only the
+// layers that changed are written when the turtle is persisted.
+type turtleHeader struct {
+	Last     types.LayerID
+	Hdist    types.LayerID
+	Evict    types.LayerID
+	Verified types.LayerID
+
+	AvgLayerSize  int
+	MaxExceptions int
+
+	Layers []types.LayerID // the layers whose state is persisted
+}
+
+// layerState is the persisted state of the blocks of a layer: their opinions, and which of them are good.
+type layerState struct {
+	Opinions map[types.BlockID]Opinion
+	Good     []types.BlockID
 }
 
 // SetLogger sets the Log instance for this turtle
@@ -92,6 +147,16 @@ func (t *turtle) init(genesisLayer *types.Layer) {
 	t.Last = genesisLayer.Index()
 	t.Evict = genesisLayer.Index()
 	t.Verified = genesisLayer.Index()
+	t.persistedEvict = genesisLayer.Index()
+	t.markDirty(genesisLayer.Index())
+}
+
+// markDirty records that the blocks of the layer changed since the tortoise was last persisted.
+func (t *turtle) markDirty(layer types.LayerID) {
+	if t.dirty == nil {
+		t.dirty = make(map[types.LayerID]struct{})
+	}
+	t.dirty[layer] = struct{}{}
 }
 
 // evict makes sure we only keep a window of the last hdist layers.
@@ -112,9 +177,20 @@ func (t *turtle) evict() {
 			delete(t.GoodBlocksIndex, blk)
 		}
 		delete(t.BlocksToBlocks, lyr)
+		delete(t.blockWeights, lyr)
 		t.logger.Debug("evict block %v from maps ", lyr)
 
 	}
+	for epoch := range t.atxWeights {
+		if epoch < window.GetEpoch() {
+			delete(t.atxWeights, epoch)
+		}
+	}
+	for epoch := range t.epochWeights {
+		if epoch < window.GetEpoch() {
+			delete(t.epochWeights, epoch)
+		}
+	}
 	t.Evict = window
 }
 
@@ -130,13 +206,22 @@ func blockIDsToString(input []types.BlockID) string {
 	return str
 }
 
+// layerVector returns the blocks of a layer the node votes for: the ones the full tortoise found valid if it verified
+// the layer, otherwise the layer's input vector.
+func (t *turtle) layerVector(lyrid types.LayerID) ([]types.BlockID, error) {
+	if healed, err := t.bdp.GetLayerHealedVector(lyrid); err == nil {
+		return healed, nil
+	}
+	return t.bdp.GetLayerInputVector(lyrid)
+}
+
 // TODO: cache but somehow check for changes (hare that didn't finish finishes..) maybe check hash?
 func (t *turtle) getSingleInputVectorFromDB(lyrid types.LayerID, blockid types.BlockID) (vec, error) {
 	if lyrid <= types.GetEffectiveGenesis() {
 		return support, nil
 	}
 
-	input, err := t.bdp.GetLayerInputVector(lyrid)
+	input, err := t.layerVector(lyrid)
 	if err != nil {
 		return abstain, err
 	}
@@ -178,9 +263,26 @@ func (t *turtle) inputVectorForLayer(lyrBlocks []types.BlockID, input []types.Bl
 }
 
 func (t *turtle) BaseBlock() (types.BlockID, [][]types.BlockID, error) {
-	// Try to find a block counting only good blocks
-	for i := t.Last; i > t.Last-t.Hdist; i-- {
-		for blk, op := range t.BlocksToBlocks[i] {
+	// Try to find a block counting only good blocks. The newest good blocks are usually in the last hdist layers, but
+	// if the verifying tortoise stalled for longer, they're before the stall: they're looked for up to the evicted
+	// layers, so that blocks can still be built, and once the full tortoise verified the stalled layers, the blocks
+	// built on them are good again.
+	for i := t.Last; i >= t.Evict && i <= t.Last; i-- {
+		// the heaviest blocks are tried first, so that making more blocks doesn't make a smesher's blocks more likely
+		// to be chosen
+		candidates := make([]types.BlockID, 0, len(t.BlocksToBlocks[i]))
+		for blk := range t.BlocksToBlocks[i] {
+			candidates = append(candidates, blk)
+		}
+		weights := t.layerBlockWeights(i)
+		sort.Slice(candidates, func(a, b int) bool {
+			if weights[candidates[a]] != weights[candidates[b]] {
+				return weights[candidates[a]] > weights[candidates[b]]
+			}
+			return candidates[a].Compare(candidates[b])
+		})
+		for _, blk := range candidates {
+			op := t.BlocksToBlocks[i][blk]
 			if _, ok := t.GoodBlocksIndex[blk]; !ok {
 				t.logger.With().Debug("can't use a not good block", log.FieldNamed("last_layer", t.Last), i, blk)
 				continue
@@ -206,6 +308,11 @@ func (t *turtle) opinionMatches(layerid types.LayerID, blockid types.BlockID, op
 	forDiff := make(map[types.BlockID]struct{})
 	neutralDiff := make(map[types.BlockID]struct{})
 
+	// a block whose ATX carries no weight doesn't count in the votes, so its opinion can't be adopted
+	if layerid > types.GetEffectiveGenesis() && t.voteWeight(layerid, blockid) == 0 {
+		return nil, fmt.Errorf("block %v has no weight", blockid)
+	}
+
 	// handle genesis
 	if layerid == types.GetEffectiveGenesis() {
 		for _, i := range types.BlockIDs(mesh.GenesisLayer().Blocks()) {
@@ -229,7 +336,7 @@ func (t *turtle) opinionMatches(layerid types.LayerID, blockid types.BlockID, op
 			return nil, err
 		}
 
-		res, err := t.bdp.GetLayerInputVector(i)
+		res, err := t.layerVector(i)
 		if err != nil {
 			t.logger.With().Debug("input vector is empty adding neutral diffs", i)
 			for _, b := range blks {
@@ -276,18 +383,274 @@ func (t *turtle) opinionMatches(layerid types.LayerID, blockid types.BlockID, op
 	return []map[types.BlockID]struct{}{againstDiff, forDiff, neutralDiff}, nil
 }
 
-func (t *turtle) BlockWeight(voting, voted types.BlockID) int {
-	return 1
+// weightUnit is the weight of a block whose ATX has the average weight of the ATXs of its epoch. The weights of the
+// ATXs are normalized to it, so that the weights of the votes don't depend on the unit of space and ticks and their sums
+// don't overflow.
+const weightUnit = 1000
+
+// maxBlockWeight bounds the normalized weight of a block, so that the sums of the votes of many heavy blocks don't
+// overflow.
+const maxBlockWeight = math.MaxInt32
+
+// voteWeight returns the weight of the votes of a block in layer. A block weighs its ATX's weight (space × ticks)
+// divided by the number of blocks the ATX made in the layer, so a smesher can't gain weight by making more blocks.
+func (t *turtle) voteWeight(layer types.LayerID, id types.BlockID) int {
+	if t.atxdb == nil {
+		return 1
+	}
+	weights := t.layerBlockWeights(layer)
+	if w, ok := weights[id]; ok {
+		return w
+	}
+	// a block that isn't in the layer's blocks yet weighs its ATX's whole weight
+	blk, err := t.bdp.GetBlock(id)
+	if err != nil {
+		t.logger.With().Warning("can't find voting block, its votes don't count", id, log.Err(err))
+		return 0
+	}
+	w, _ := t.normalizedAtxWeight(layer.GetEpoch(), blk.ATXID)
+	return w
+}
+
+// layerBlockWeights returns the weights of the blocks of the layer, computed when first needed. They're only cached
+// once the blocks and their ATXs are all known, until then the missing ones weigh 0 and the weights are only kept
+// while the current layer is handled.
+func (t *turtle) layerBlockWeights(layer types.LayerID) map[types.BlockID]int {
+	if weights, ok := t.blockWeights[layer]; ok {
+		return weights
+	}
+	if weights, ok := t.incompleteWeights[layer]; ok {
+		return weights
+	}
+	weights := make(map[types.BlockID]int)
+	if t.atxdb == nil {
+		return weights
+	}
+	ids, err := t.bdp.LayerBlockIds(layer)
+	if err != nil {
+		return weights
+	}
+	complete := true
+	byAtx := make(map[types.ATXID][]types.BlockID)
+	for _, id := range ids {
+		blk, err := t.bdp.GetBlock(id)
+		if err != nil {
+			t.logger.With().Warning("can't find block of layer, its votes don't count", layer, id, log.Err(err))
+			complete = false
+			continue
+		}
+		byAtx[blk.ATXID] = append(byAtx[blk.ATXID], id)
+	}
+	for atx, blocks := range byAtx {
+		w, ok := t.normalizedAtxWeight(layer.GetEpoch(), atx)
+		complete = complete && ok
+		for _, id := range blocks {
+			weights[id] = w / len(blocks)
+		}
+	}
+	if complete {
+		if t.blockWeights == nil {
+			t.blockWeights = make(map[types.LayerID]map[types.BlockID]int)
+		}
+		t.blockWeights[layer] = weights
+	} else if t.incompleteWeights != nil {
+		t.incompleteWeights[layer] = weights
+	}
+	return weights
+}
+
+// normalizedAtxWeight returns the weight of an ATX of the blocks of the epoch in weight units, bounded by
+// maxBlockWeight. It returns false if the ATX or the ATXs of the epoch are unknown, then the weight is 0.
+func (t *turtle) normalizedAtxWeight(epoch types.EpochID, id types.ATXID) (int, bool) {
+	ew, ok := t.epochWeight(epoch)
+	if !ok {
+		return 0, false
+	}
+	w, ok := t.atxWeight(epoch, id)
+	if !ok {
+		return 0, false
+	}
+	avg := ew.total / uint64(ew.atxs)
+	hi, lo := bits.Mul64(w, weightUnit)
+	if hi >= avg {
+		return maxBlockWeight, true
+	}
+	normalized, _ := bits.Div64(hi, lo, avg)
+	if normalized > maxBlockWeight {
+		return maxBlockWeight, true
+	}
+	return int(normalized), true
+}
+
+// atxWeight returns the weight of an ATX of the blocks of the epoch. It returns false if the ATX is unknown.
+func (t *turtle) atxWeight(epoch types.EpochID, id types.ATXID) (uint64, bool) {
+	if w, ok := t.atxWeights[epoch][id]; ok {
+		return w, true
+	}
+	atx, err := t.atxdb.GetAtxHeader(id)
+	if err != nil {
+		// not cached, the ATX may still arrive
+		t.logger.With().Warning("can't find ATX of block, its votes don't count", epoch, id, log.Err(err))
+		return 0, false
+	}
+	if t.atxWeights == nil {
+		t.atxWeights = make(map[types.EpochID]map[types.ATXID]uint64)
+	}
+	if t.atxWeights[epoch] == nil {
+		t.atxWeights[epoch] = make(map[types.ATXID]uint64)
+	}
+	t.atxWeights[epoch][id] = atx.GetWeight()
+	return atx.GetWeight(), true
+}
+
+// epochWeight is the total weight of the ATXs of the blocks of an epoch, and their number.
+type epochWeight struct {
+	total uint64
+	atxs  int
 }
 
-// Persist saves the current tortoise state to the database
+// epochWeight returns the weight of the ATXs of the blocks of the epoch. It returns false if the epoch has no ATXs or
+// they can't be read.
+func (t *turtle) epochWeight(epoch types.EpochID) (epochWeight, bool) {
+	if w, ok := t.epochWeights[epoch]; ok {
+		return w, true
+	}
+	// not cached until the epoch has ATXs, they may still arrive
+	total, atxs, err := t.atxdb.GetEpochWeight(epoch)
+	if err != nil {
+		t.logger.With().Warning("can't read ATXs of epoch, its blocks don't count", epoch, log.Err(err))
+		return epochWeight{}, false
+	}
+	if len(atxs) == 0 || total < uint64(len(atxs)) {
+		return epochWeight{}, false
+	}
+	if t.epochWeights == nil {
+		t.epochWeights = make(map[types.EpochID]epochWeight)
+	}
+	t.epochWeights[epoch] = epochWeight{total: total, atxs: len(atxs)}
+	return t.epochWeights[epoch], true
+}
+
+// layerWeight returns the expected weight of the blocks of a layer. The weights of the blocks are normalized to the
+// average weight of the ATXs of the layer's epoch, and each ATX that made blocks in the layer weighs its weight in
+// total, so the layer weighs weightUnit times the average layer size, or times the number of ATXs of the epoch if
+// there are fewer.
+func (t *turtle) layerWeight(layer types.LayerID) int {
+	if t.atxdb == nil {
+		return t.AvgLayerSize
+	}
+	atxs := t.AvgLayerSize
+	if ew, ok := t.epochWeight(layer.GetEpoch()); ok && ew.atxs < atxs {
+		atxs = ew.atxs
+	}
+	return atxs * weightUnit
+}
+
+// persist saves the changes to the tortoise state since it was last persisted to the database: its header, the state
+// of the layers whose blocks changed, and the deletion of the layers evicted since. They're written in a single batch,
+// so if the node is killed while persisting, the persisted state is the state before or after the call.
 func (t *turtle) persist() error {
-	return t.bdp.Persist(mesh.TORTOISE, t)
+	header := &turtleHeader{
+		Last:          t.Last,
+		Hdist:         t.Hdist,
+		Evict:         t.Evict,
+		Verified:      t.Verified,
+		AvgLayerSize:  t.AvgLayerSize,
+		MaxExceptions: t.MaxExceptions,
+		Layers:        make([]types.LayerID, 0, len(t.BlocksToBlocks)),
+	}
+	for l := range t.BlocksToBlocks {
+		header.Layers = append(header.Layers, l)
+	}
+	sort.Slice(header.Layers, func(i, j int) bool { return header.Layers[i] < header.Layers[j] })
+
+	keys := [][]byte{mesh.TortoiseHeader}
+	values := []interface{}{header}
+	for l := range t.dirty {
+		// the layers evicted before they were persisted aren't written
+		if _, ok := t.BlocksToBlocks[l]; ok {
+			keys = append(keys, mesh.TortoiseLayerKey(l))
+			values = append(values, t.layerState(l))
+		}
+	}
+	for l := t.persistedEvict; l < t.Evict; l++ {
+		// a late block may have added an evicted layer again
+		if _, ok := t.BlocksToBlocks[l]; !ok {
+			keys = append(keys, mesh.TortoiseLayerKey(l))
+			values = append(values, nil)
+		}
+	}
+	if t.legacy {
+		keys = append(keys, mesh.TORTOISE)
+		values = append(values, nil)
+	}
+	if err := t.bdp.PersistBatch(keys, values); err != nil {
+		return err
+	}
+	t.dirty = nil
+	t.persistedEvict = t.Evict
+	t.legacy = false
+	return nil
+}
+
+// layerState returns the state of the blocks of the layer to persist.
+func (t *turtle) layerState(layer types.LayerID) *layerState {
+	state := &layerState{Opinions: t.BlocksToBlocks[layer]}
+	for id := range t.BlocksToBlocks[layer] {
+		if _, ok := t.GoodBlocksIndex[id]; ok {
+			state.Good = append(state.Good, id)
+		}
+	}
+	types.SortBlockIDs(state.Good)
+	return state
 }
 
 // RecoverVerifyingTortoise retrieve latest saved tortoise from the database
 func RecoverVerifyingTortoise(mdb retriever) (interface{}, error) {
-	return mdb.Retrieve(mesh.TORTOISE, &turtle{})
+	header := &turtleHeader{}
+	if _, err := mdb.Retrieve(mesh.TortoiseHeader, header); err != nil {
+		// the tortoise may have been persisted whole by an earlier version, its layers are persisted separately the
+		// next time it's persisted
+		tmp, lerr := mdb.Retrieve(mesh.TORTOISE, &turtle{})
+		if lerr != nil {
+			return nil, err
+		}
+		t := tmp.(*turtle)
+		for l := range t.BlocksToBlocks {
+			t.markDirty(l)
+		}
+		t.persistedEvict = t.Evict
+		t.legacy = true
+		return t, nil
+	}
+
+	t := &turtle{
+		Last:                header.Last,
+		Hdist:               header.Hdist,
+		Evict:               header.Evict,
+		Verified:            header.Verified,
+		AvgLayerSize:        header.AvgLayerSize,
+		MaxExceptions:       header.MaxExceptions,
+		GoodBlocksIndex:     make(map[types.BlockID]struct{}),
+		BlocksToBlocks:      make(map[types.LayerID]map[types.BlockID]Opinion, len(header.Layers)),
+		BlocksToBlocksIndex: make(map[types.BlockID]int),
+		persistedEvict:      header.Evict,
+	}
+	for _, l := range header.Layers {
+		state := &layerState{}
+		if _, err := mdb.Retrieve(mesh.TortoiseLayerKey(l), state); err != nil {
+			return nil, fmt.Errorf("failed to recover tortoise state of layer %v: %v", l, err)
+		}
+		opinions := make(map[types.BlockID]Opinion, len(state.Opinions))
+		for id, opinion := range state.Opinions {
+			opinions[id] = opinion
+		}
+		t.BlocksToBlocks[l] = opinions
+		for _, id := range state.Good {
+			t.GoodBlocksIndex[id] = struct{}{}
+		}
+	}
+	return t, nil
 }
 
 func (t *turtle) processBlock(block *types.Block) error {
@@ -325,11 +688,12 @@ func (t *turtle) processBlock(block *types.Block) error {
 		thisBlockOpinions[blk] = vote
 	}
 
+	// the opinions are recorded unweighted, the block's weight is applied when its votes are counted
 	for _, b := range block.ForDiff {
-		thisBlockOpinions[b] = thisBlockOpinions[b].Add(support.Multiply(t.BlockWeight(blockid, b)))
+		thisBlockOpinions[b] = thisBlockOpinions[b].Add(support)
 	}
 	for _, b := range block.AgainstDiff {
-		thisBlockOpinions[b] = thisBlockOpinions[b].Add(against.Multiply(t.BlockWeight(blockid, b)))
+		thisBlockOpinions[b] = thisBlockOpinions[b].Add(against)
 	}
 
 	//TODO: neutral ?
@@ -357,6 +721,11 @@ func (t *turtle) HandleIncomingLayer(newlyr *types.Layer, inputVector []types.Bl
 
 	defer t.evict()
 
+	// the layer's blocks may have changed since their weights were computed
+	delete(t.blockWeights, newlyr.Index())
+	t.incompleteWeights = make(map[types.LayerID]map[types.BlockID]int)
+	defer func() { t.incompleteWeights = nil }()
+
 	t.logger.With().Info("start handling layer", newlyr.Index(), log.Int("blocks", len(newlyr.Blocks())))
 
 	// update tables with blocks
@@ -365,6 +734,7 @@ func (t *turtle) HandleIncomingLayer(newlyr *types.Layer, inputVector []types.Bl
 		if !ok {
 			t.BlocksToBlocks[b.LayerIndex] = make(map[types.BlockID]Opinion, t.AvgLayerSize)
 		}
+		t.markDirty(b.LayerIndex)
 		err := t.processBlock(b)
 		if err != nil {
 			log.Panic(fmt.Sprintf("something is wrong err:%v", err))
@@ -440,7 +810,6 @@ markingLoop:
 	wasVerified := t.Verified
 	t.logger.With().Info("starting layer verification", log.FieldNamed("was_verified", wasVerified), log.FieldNamed("target_verification", newlyr.Index()))
 	i := wasVerified + 1
-loop:
 	for ; i < idx; i++ {
 		t.logger.With().Info("verifying layer", i)
 
@@ -468,53 +837,28 @@ loop:
 			break
 		}
 
-		contextualValidity := make(map[types.BlockID]bool, len(blks))
-
-		// Count good blocks votes..
-		// Declare the vote vector “verified” up to position k if the total weight exceeds the confidence threshold in all positions up to k .
-		for blk, vote := range input {
-			// Count the votes for the input vote vector by summing the weight of the good blocks
-			sum := abstain
-			//t.logger.Info("counting votes for block %v", blk)
-			for j := t.Last; j > i && j-i < t.Hdist; j-- {
-				// check if the block is good
-				for bid, op := range t.BlocksToBlocks[j] {
-					_, isgood := t.GoodBlocksIndex[bid]
-					if !isgood {
-						t.logger.With().Debug("block not good hence not counting", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk))
-						continue
-					}
-
-					// check if this block has Opinion on this block. (TODO: maybe doesn't have Opinion means AGAINST?)
-					opinionVote, ok := op.BlocksOpinion[blk]
-					if !ok {
-						t.logger.With().Debug("no opinion on block", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk))
-						continue
-					}
-
-					t.logger.With().Debug("adding block opinion to vote sum", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk),
-						log.String("vote", opinionVote.String()), log.String("sum", fmt.Sprintf("[%v, %v]", sum[0], sum[1])))
-					sum = sum.Add(opinionVote.Multiply(t.BlockWeight(bid, blk)))
-				}
+		contextualValidity, ok := t.goodBlocksOpinion(i, wasVerified, input)
+		if !ok {
+			// the verifying tortoise can't verify the layer, if it fell too far behind, fall back to the full tortoise
+			if t.fullTortoiseDistance == 0 || t.Last-i <= t.fullTortoiseDistance {
+				break
 			}
-
-			// check that the total weight exceeds the confidence threshold in all positions up
-			threshold := float64(globalThreshold*float64(i-wasVerified)) * float64(t.AvgLayerSize)
-			t.logger.With().Debug("global opinion", sum, log.String("threshold", fmt.Sprint(threshold)))
-			gop := globalOpinion(sum, t.AvgLayerSize, float64(i-wasVerified))
-			t.logger.With().Debug("calculated global opinion on block", log.FieldNamed("voted_block", blk), i, log.String("global_opinion", gop.String()), log.String("sum", fmt.Sprintf("[%v, %v]", sum[0], sum[1])))
-			if gop != vote {
-				// TODO: trigger self healing after a while ?
-				t.logger.With().Warning("global opinion is different from vote", log.String("global_opinion", gop.String()), log.String("vote", vote.String()))
-				break loop
+			if contextualValidity, ok = t.fullOpinion(i, blks); !ok {
+				break
 			}
-
-			if gop == abstain {
-				t.logger.With().Warning("global opinion on a block is abstain hence can't verify layer", log.String("global_opinion", gop.String()), log.String("vote", vote.String()))
-				break loop
+			// the next blocks vote for the blocks the full tortoise found valid instead of the input vector, so that
+			// they're marked good and the verifying tortoise can take over again. The input vector keeps the hare's
+			// result.
+			valid := make([]types.BlockID, 0, len(contextualValidity))
+			for blk, v := range contextualValidity {
+				if v {
+					valid = append(valid, blk)
+				}
 			}
-
-			contextualValidity[blk] = gop == support
+			if err := t.bdp.SaveLayerHealedVector(i, types.SortBlockIDs(valid)); err != nil {
+				t.logger.With().Error("error saving the valid blocks of layer verified by full tortoise", i, log.Err(err))
+			}
+			t.logger.With().Info("full tortoise verified layer", i, log.FieldNamed("last_layer", t.Last), log.Int("valid_blocks", len(valid)))
 		}
 
 		//Declare the vote vector “verified” up to position k.
@@ -531,3 +875,217 @@ loop:
 
 	return wasVerified, t.Verified
 }
+
+// goodBlocksOpinion counts the votes of the good blocks on the blocks of layer i, and returns their validity if the
+// global opinion on all of them agrees with the input vector.
+func (t *turtle) goodBlocksOpinion(i, wasVerified types.LayerID, input map[types.BlockID]vec) (map[types.BlockID]bool, bool) {
+	contextualValidity := make(map[types.BlockID]bool, len(input))
+
+	// Count good blocks votes..
+	// Declare the vote vector “verified” up to position k if the total weight exceeds the confidence threshold in all positions up to k .
+	for blk, vote := range input {
+		// Count the votes for the input vote vector by summing the weight of the good blocks
+		sum := t.goodBlocksVotes(i, blk)
+
+		// check that the total weight exceeds the confidence threshold in all positions up
+		threshold := float64(globalThreshold*float64(i-wasVerified)) * float64(t.layerWeight(i))
+		t.logger.With().Debug("global opinion", sum, log.String("threshold", fmt.Sprint(threshold)))
+		gop := globalOpinion(sum, t.layerWeight(i), float64(i-wasVerified))
+		t.logger.With().Debug("calculated global opinion on block", log.FieldNamed("voted_block", blk), i, log.String("global_opinion", gop.String()), log.String("sum", fmt.Sprintf("[%v, %v]", sum[0], sum[1])))
+		if gop != vote {
+			t.logger.With().Warning("global opinion is different from vote", log.String("global_opinion", gop.String()), log.String("vote", vote.String()))
+			return nil, false
+		}
+
+		if gop == abstain {
+			t.logger.With().Warning("global opinion on a block is abstain hence can't verify layer", log.String("global_opinion", gop.String()), log.String("vote", vote.String()))
+			return nil, false
+		}
+
+		contextualValidity[blk] = gop == support
+	}
+	return contextualValidity, true
+}
+
+// goodBlocksVotes sums the weighted votes of the good blocks of the layers after layer i on a block of layer i.
+func (t *turtle) goodBlocksVotes(i types.LayerID, blk types.BlockID) vec {
+	sum := abstain
+	for j := t.Last; j > i && j-i < t.Hdist; j-- {
+		// check if the block is good
+		for bid, op := range t.BlocksToBlocks[j] {
+			_, isgood := t.GoodBlocksIndex[bid]
+			if !isgood {
+				t.logger.With().Debug("block not good hence not counting", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk))
+				continue
+			}
+
+			// check if this block has Opinion on this block. (TODO: maybe doesn't have Opinion means AGAINST?)
+			opinionVote, ok := op.BlocksOpinion[blk]
+			if !ok {
+				t.logger.With().Debug("no opinion on block", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk))
+				continue
+			}
+
+			t.logger.With().Debug("adding block opinion to vote sum", log.FieldNamed("voting_block", bid), log.FieldNamed("voted_block", blk),
+				log.String("vote", opinionVote.String()), log.String("sum", fmt.Sprintf("[%v, %v]", sum[0], sum[1])))
+			sum = sum.Add(opinionVote.Multiply(t.voteWeight(j, bid)))
+		}
+	}
+	return sum
+}
+
+// fullOpinion counts the votes of all the blocks after layer i on its blocks, good or not. A block that has no opinion
+// on a block of an earlier layer implicitly votes against it. It returns the blocks' validity if the global opinion on
+// all of them isn't abstain.
+func (t *turtle) fullOpinion(i types.LayerID, blks []types.BlockID) (map[types.BlockID]bool, bool) {
+	contextualValidity := make(map[types.BlockID]bool, len(blks))
+	for _, blk := range blks {
+		sum := abstain
+		for j := i + 1; j <= t.Last; j++ {
+			for bid, op := range t.BlocksToBlocks[j] {
+				opinionVote, ok := op.BlocksOpinion[blk]
+				if !ok {
+					opinionVote = against
+				}
+				sum = sum.Add(opinionVote.Multiply(t.voteWeight(j, bid)))
+			}
+		}
+		gop := globalOpinion(sum, t.layerWeight(i), float64(t.Last-i))
+		t.logger.With().Debug("calculated full tortoise opinion on block", log.FieldNamed("voted_block", blk), i, log.String("global_opinion", gop.String()), log.String("sum", fmt.Sprintf("[%v, %v]", sum[0], sum[1])))
+		if gop == abstain {
+			t.logger.With().Warning("full tortoise opinion on a block is abstain hence can't verify layer", i, log.FieldNamed("voted_block", blk))
+			return nil, false
+		}
+		contextualValidity[blk] = gop == support
+	}
+	return contextualValidity, true
+}
+
+// inspectLayer returns the tortoise's view of a layer: the opinions of the blocks of the later layers on its blocks,
+// the tallies of the good blocks' votes, and why the layer isn't verified yet.
+func (t *turtle) inspectLayer(layer types.LayerID) (*types.LayerInspection, error) {
+	if layer < t.Evict || layer > t.Last {
+		return nil, fmt.Errorf("layer %v is outside of the tortoise's window, layers %v to %v", layer, t.Evict, t.Last)
+	}
+	// the weights computed for the inspection are cached in copies of the caches, so it doesn't change the tortoise
+	t = t.withWeightsCopy()
+	blks, err := t.bdp.LayerBlockIds(layer)
+	if err != nil {
+		return nil, fmt.Errorf("can't find the blocks of layer %v: %v", layer, err)
+	}
+	raw, err := t.bdp.GetLayerInputVector(layer)
+	if err != nil {
+		// the input vector abstains on all blocks
+		raw = nil
+	}
+	if layer <= types.GetEffectiveGenesis() {
+		raw = blks
+	}
+	input := t.inputVectorForLayer(blks, raw)
+
+	// the threshold the layer is held to when the layers before it are verified
+	delta := float64(1)
+	if layer > t.Verified {
+		delta = float64(layer - t.Verified)
+	}
+	weight := t.layerWeight(layer)
+	inspection := &types.LayerInspection{
+		Layer:     layer,
+		Last:      t.Last,
+		Verified:  t.Verified,
+		Threshold: globalThreshold * delta * float64(weight),
+	}
+	for _, blk := range types.SortBlockIDs(blks) {
+		_, good := t.GoodBlocksIndex[blk]
+		sum := t.goodBlocksVotes(layer, blk)
+		bi := types.BlockInspection{
+			ID:            blk,
+			Good:          good,
+			Weight:        t.voteWeight(layer, blk),
+			Input:         input[blk].String(),
+			Tally:         types.VoteTally{Support: sum[0], Against: sum[1]},
+			GlobalOpinion: globalOpinion(sum, weight, delta).String(),
+		}
+		for j := layer + 1; j <= t.Last; j++ {
+			for voter, op := range t.BlocksToBlocks[j] {
+				v, ok := op.BlocksOpinion[blk]
+				if !ok {
+					continue
+				}
+				_, good := t.GoodBlocksIndex[voter]
+				bi.Votes = append(bi.Votes, types.BlockVote{
+					Voter:  voter,
+					Layer:  j,
+					Good:   good,
+					Weight: t.voteWeight(j, voter),
+					Vote:   types.VoteTally{Support: v[0], Against: v[1]},
+				})
+			}
+		}
+		sort.Slice(bi.Votes, func(i, j int) bool {
+			if bi.Votes[i].Layer != bi.Votes[j].Layer {
+				return bi.Votes[i].Layer < bi.Votes[j].Layer
+			}
+			return bi.Votes[i].Voter.Compare(bi.Votes[j].Voter)
+		})
+		inspection.Blocks = append(inspection.Blocks, bi)
+	}
+	inspection.Reason = t.notVerifiedReason(inspection)
+	return inspection, nil
+}
+
+// withWeightsCopy returns a shallow copy of the turtle whose weight caches are copies of the turtle's, so the weights
+// it computes aren't cached in the turtle.
+func (t *turtle) withWeightsCopy() *turtle {
+	cp := *t
+	cp.epochWeights = make(map[types.EpochID]epochWeight, len(t.epochWeights))
+	for epoch, w := range t.epochWeights {
+		cp.epochWeights[epoch] = w
+	}
+	cp.atxWeights = make(map[types.EpochID]map[types.ATXID]uint64, len(t.atxWeights))
+	for epoch, weights := range t.atxWeights {
+		cp.atxWeights[epoch] = make(map[types.ATXID]uint64, len(weights))
+		for id, w := range weights {
+			cp.atxWeights[epoch][id] = w
+		}
+	}
+	// the cached weights of a layer's blocks are never changed, only replaced
+	cp.blockWeights = make(map[types.LayerID]map[types.BlockID]int, len(t.blockWeights))
+	for layer, weights := range t.blockWeights {
+		cp.blockWeights[layer] = weights
+	}
+	return &cp
+}
+
+// notVerifiedReason explains why an inspected layer isn't verified, or returns an empty string if it is.
+func (t *turtle) notVerifiedReason(inspection *types.LayerInspection) string {
+	layer := inspection.Layer
+	switch {
+	case layer <= t.Verified:
+		return ""
+	case layer > t.Verified+1:
+		return fmt.Sprintf("layer %v before it isn't verified", t.Verified+1)
+	case layer == t.Last:
+		return "no layer after it was handled yet, a layer is verified by the votes of the later layers"
+	case len(inspection.Blocks) == 0:
+		return "the layer has no blocks"
+	}
+	var reason string
+	for _, bi := range inspection.Blocks {
+		if bi.GlobalOpinion == abstain.String() {
+			reason = fmt.Sprintf("the good blocks' votes on block %v don't reach the threshold: %v for and %v against", bi.ID, bi.Tally.Support, bi.Tally.Against)
+			break
+		}
+		if bi.GlobalOpinion != bi.Input {
+			reason = fmt.Sprintf("the good blocks' global opinion on block %v is %v, but the layer's input vector votes %v", bi.ID, bi.GlobalOpinion, bi.Input)
+			break
+		}
+	}
+	if reason == "" {
+		return "the good blocks agree with the layer's input vector, the layer will be verified when the next layer is handled"
+	}
+	if t.fullTortoiseDistance != 0 {
+		reason += fmt.Sprintf(", the full tortoise takes over when the last layer is %v", layer+t.fullTortoiseDistance+1)
+	}
+	return reason
+}
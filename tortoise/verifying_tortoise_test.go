@@ -6,11 +6,13 @@ import (
 	"github.com/spacemeshos/go-spacemesh/config"
 	"github.com/spacemeshos/go-spacemesh/signing"
 	"github.com/stretchr/testify/assert"
+	"math"
 	"os"
 	"strconv"
 	"testing"
 
 	"github.com/spacemeshos/go-spacemesh/common/types"
+	"github.com/spacemeshos/go-spacemesh/database"
 	"github.com/spacemeshos/go-spacemesh/log"
 	"github.com/spacemeshos/go-spacemesh/mesh"
 	"github.com/stretchr/testify/require"
@@ -34,7 +36,7 @@ func getPersistentMash() (*mesh.DB, func() error) {
 		panic(err)
 	}
 	db, _ := mesh.NewPersistentMeshDB(fmt.Sprintf(path+
-		"/"), 10, log.NewDefault("ninje_tortoise").WithOptions(log.Nop))
+		"/"), database.LevelDBBackend, 10, log.NewDefault("ninje_tortoise").WithOptions(log.Nop))
 	return db, teardown
 }
 
@@ -74,10 +76,10 @@ func requireVote(t *testing.T, trtl *turtle, vote vec, blocks ...types.BlockID)
 				}
 
 				//t.logger.Info("block %v is good and voting vote %v", vopinion.id, opinionVote)
-				sum = sum.Add(opinionVote.Multiply(trtl.BlockWeight(bid, i)))
+				sum = sum.Add(opinionVote.Multiply(trtl.voteWeight(l, bid)))
 			}
 		}
-		gop := globalOpinion(sum, trtl.AvgLayerSize, 1)
+		gop := globalOpinion(sum, trtl.layerWeight(blk.LayerIndex), 1)
 		if gop != vote {
 			require.Fail(t, fmt.Sprintf("crashing test block %v should be %v but %v", i, vote, sum))
 		}
@@ -444,3 +446,452 @@ func TestTurtle_Recovery(t *testing.T) {
 	l3res, _ := getHareResults(types.GetEffectiveGenesis() + 3)
 	alg.HandleIncomingLayer(l32, l3res) //crash
 }
+
+// killableStore records the batches the tortoise persists, and simulates the node being killed while persisting: it
+// panics before or after writing a batch when killed.
+type killableStore struct {
+	*mesh.DB
+	batches []persistedBatch
+	kill    int
+}
+
+type persistedBatch struct {
+	keys   [][]byte
+	values []interface{}
+}
+
+const (
+	notKilled = iota
+	killedBeforeWrite
+	killedAfterWrite
+)
+
+func (s *killableStore) PersistBatch(keys [][]byte, values []interface{}) error {
+	if s.kill == killedBeforeWrite {
+		panic("killed before writing the batch")
+	}
+	s.batches = append(s.batches, persistedBatch{keys: keys, values: values})
+	if err := s.DB.PersistBatch(keys, values); err != nil {
+		return err
+	}
+	if s.kill == killedAfterWrite {
+		panic("killed after writing the batch")
+	}
+	return nil
+}
+
+// makeTurtleLayer creates a layer whose blocks vote like the tortoise's base block, and stores it with an input
+// vector that supports all of its blocks.
+func makeTurtleLayer(t *testing.T, trtl *turtle, msh *mesh.DB, l types.LayerID, blocksPerLayer int) (*types.Layer, []types.BlockID) {
+	b, lists, err := trtl.BaseBlock()
+	require.NoError(t, err)
+	lyr := types.NewLayer(l)
+	for i := 0; i < blocksPerLayer; i++ {
+		blk := types.NewExistingBlock(l, []byte(strconv.Itoa(i)), nil)
+		blk.BaseBlock = b
+		blk.AgainstDiff = lists[0]
+		blk.ForDiff = lists[1]
+		blk.NeutralDiff = lists[2]
+		blk.Initialize()
+		lyr.AddBlock(blk)
+		require.NoError(t, msh.AddBlock(blk))
+	}
+	input := types.BlockIDs(lyr.Blocks())
+	require.NoError(t, msh.SaveLayerInputVector(l, input))
+	return lyr, input
+}
+
+func recoverTurtle(t *testing.T, mdb retriever) *turtle {
+	tmp, err := RecoverVerifyingTortoise(mdb)
+	require.NoError(t, err)
+	return tmp.(*turtle)
+}
+
+// requireSameTurtle compares the persisted state of two turtles. The opinions are compared by their votes, since
+// the encoding doesn't tell empty maps from nil ones.
+func requireSameTurtle(t *testing.T, expected, actual *turtle) {
+	r := require.New(t)
+	r.Equal(expected.Last, actual.Last)
+	r.Equal(expected.Hdist, actual.Hdist)
+	r.Equal(expected.Evict, actual.Evict)
+	r.Equal(expected.Verified, actual.Verified)
+	r.Equal(expected.AvgLayerSize, actual.AvgLayerSize)
+	r.Equal(expected.MaxExceptions, actual.MaxExceptions)
+	r.Equal(expected.GoodBlocksIndex, actual.GoodBlocksIndex)
+	r.Len(actual.BlocksToBlocks, len(expected.BlocksToBlocks))
+	for l, opinions := range expected.BlocksToBlocks {
+		r.Len(actual.BlocksToBlocks[l], len(opinions), "layer %v", l)
+		for id, opinion := range opinions {
+			actualOpinion, ok := actual.BlocksToBlocks[l][id]
+			r.True(ok, "block %v of layer %v", id, l)
+			r.Len(actualOpinion.BlocksOpinion, len(opinion.BlocksOpinion))
+			for voted, v := range opinion.BlocksOpinion {
+				r.Equal(v, actualOpinion.BlocksOpinion[voted])
+			}
+		}
+	}
+}
+
+func TestTurtle_PersistChangedLayers(t *testing.T) {
+	r := require.New(t)
+	const blocksPerLayer = 5
+	last := types.GetEffectiveGenesis() + types.LayerID(3*defaultTestHdist)
+
+	msh := getInMemMesh()
+	store := &killableStore{DB: msh}
+	trtl := newTurtle(store, defaultTestHdist, blocksPerLayer)
+	trtl.init(mesh.GenesisLayer())
+	r.NoError(trtl.persist())
+	deleted := 0
+	for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+		lyr, input := makeTurtleLayer(t, trtl, msh, l, blocksPerLayer)
+		trtl.HandleIncomingLayer(lyr, input)
+		r.NoError(trtl.persist())
+
+		// only the header and the handled layer are written, whatever the number of layers in the window, and the
+		// layers evicted meanwhile are deleted
+		batch := store.batches[len(store.batches)-1]
+		r.Equal(mesh.TortoiseHeader, batch.keys[0])
+		r.Equal(mesh.TortoiseLayerKey(l), batch.keys[1])
+		for _, v := range batch.values[2:] {
+			r.Nil(v)
+			deleted++
+		}
+		requireSameTurtle(t, trtl, recoverTurtle(t, msh))
+	}
+	r.Greater(int(trtl.Evict), int(types.GetEffectiveGenesis()))
+	r.Equal(int(trtl.Evict-types.GetEffectiveGenesis()), deleted)
+	_, err := msh.Retrieve(mesh.TortoiseLayerKey(types.GetEffectiveGenesis()), &layerState{})
+	r.Error(err)
+
+	// nothing changed, only the header is written
+	r.NoError(trtl.persist())
+	r.Len(store.batches[len(store.batches)-1].keys, 1)
+}
+
+func TestTurtle_RecoverAfterKill(t *testing.T) {
+	r := require.New(t)
+	const blocksPerLayer = 5
+	last := types.GetEffectiveGenesis() + types.LayerID(3*defaultTestHdist)
+	kills := map[types.LayerID]int{
+		types.GetEffectiveGenesis() + 2:                                   killedBeforeWrite,
+		types.GetEffectiveGenesis() + 3:                                   killedAfterWrite,
+		types.GetEffectiveGenesis() + types.LayerID(defaultTestHdist) + 3: killedBeforeWrite,
+		types.GetEffectiveGenesis() + types.LayerID(defaultTestHdist) + 4: killedAfterWrite,
+		last: killedBeforeWrite,
+	}
+
+	msh := getInMemMesh()
+	// the reference tortoise handles the same layers without being killed
+	reference := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+	reference.init(mesh.GenesisLayer())
+	store := &killableStore{DB: msh}
+	trtl := newTurtle(store, defaultTestHdist, blocksPerLayer)
+	trtl.init(mesh.GenesisLayer())
+	r.NoError(trtl.persist())
+	for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+		lyr, input := makeTurtleLayer(t, reference, msh, l, blocksPerLayer)
+		reference.HandleIncomingLayer(lyr, input)
+		trtl.HandleIncomingLayer(lyr, input)
+		store.kill = kills[l]
+		if store.kill == notKilled {
+			r.NoError(trtl.persist())
+			continue
+		}
+		r.Panics(func() { trtl.persist() })
+		store.kill = notKilled
+
+		// the node restarts and recovers the tortoise as it was persisted before or after the layer was handled
+		recovered := recoverTurtle(t, msh)
+		if kills[l] == killedBeforeWrite {
+			r.Equal(l-1, recovered.Last)
+			r.NotContains(recovered.BlocksToBlocks, l)
+		} else {
+			requireSameTurtle(t, reference, recovered)
+		}
+		// the layer wasn't recorded as processed, so it's handled again
+		recovered.bdp = store
+		recovered.SetLogger(log.NewDefault(t.Name()))
+		recovered.HandleIncomingLayer(lyr, input)
+		requireSameTurtle(t, reference, recovered)
+		r.NoError(recovered.persist())
+		trtl = recovered
+	}
+	requireSameTurtle(t, reference, recoverTurtle(t, msh))
+}
+
+func TestTurtle_RecoverLegacy(t *testing.T) {
+	r := require.New(t)
+	const blocksPerLayer = 5
+
+	msh := getInMemMesh()
+	trtl := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+	trtl.init(mesh.GenesisLayer())
+	for l := types.GetEffectiveGenesis() + 1; l <= types.GetEffectiveGenesis()+3; l++ {
+		lyr, input := makeTurtleLayer(t, trtl, msh, l, blocksPerLayer)
+		trtl.HandleIncomingLayer(lyr, input)
+	}
+	// earlier versions persisted the whole turtle
+	r.NoError(msh.Persist(mesh.TORTOISE, trtl))
+
+	recovered := recoverTurtle(t, msh)
+	requireSameTurtle(t, trtl, recovered)
+	recovered.bdp = msh
+	r.NoError(recovered.persist())
+	_, err := msh.Retrieve(mesh.TORTOISE, &turtle{})
+	r.Error(err)
+	requireSameTurtle(t, trtl, recoverTurtle(t, msh))
+}
+
+func TestTurtle_FullTortoiseHealsStall(t *testing.T) {
+	const blocksPerLayer = 10
+	last := types.GetEffectiveGenesis() + 15
+	// the hare never finishes for these layers, so the verifying tortoise stalls before them
+	failed := map[types.LayerID]bool{types.GetEffectiveGenesis() + 3: true, types.GetEffectiveGenesis() + 4: true}
+
+	run := func(distance types.LayerID) (*turtle, *mesh.DB) {
+		msh := getInMemMesh()
+		trtl := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+		trtl.fullTortoiseDistance = distance
+		trtl.init(mesh.GenesisLayer())
+		for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+			b, lists, err := trtl.BaseBlock()
+			require.NoError(t, err)
+			lyr := types.NewLayer(l)
+			for i := 0; i < blocksPerLayer; i++ {
+				blk := types.NewExistingBlock(l, []byte(strconv.Itoa(i)), nil)
+				blk.BaseBlock = b
+				blk.AgainstDiff = lists[0]
+				blk.ForDiff = lists[1]
+				blk.NeutralDiff = lists[2]
+				blk.Initialize()
+				lyr.AddBlock(blk)
+				require.NoError(t, msh.AddBlock(blk))
+			}
+			var input []types.BlockID
+			if !failed[l] {
+				input = types.BlockIDs(lyr.Blocks())
+				require.NoError(t, msh.SaveLayerInputVector(l, input))
+			}
+			trtl.HandleIncomingLayer(lyr, input)
+		}
+		return trtl, msh
+	}
+
+	trtl, _ := run(0)
+	require.Equal(t, int(types.GetEffectiveGenesis()+2), int(trtl.Verified))
+
+	// the full tortoise verifies the failed layers once they're more than 3 layers behind, before the stall is older than
+	// hdist, and the layers after them are verified by the verifying tortoise again. Since it verified several layers
+	// at once, the threshold of the next layer counts from the last of them and it stays a layer further behind.
+	trtl, msh := run(3)
+	require.Equal(t, int(last-2), int(trtl.Verified))
+	for l := range failed {
+		ids, err := msh.LayerBlockIds(l)
+		require.NoError(t, err)
+		for _, id := range ids {
+			valid, err := msh.ContextualValidity(id)
+			require.NoError(t, err)
+			require.False(t, valid, "no block voted for the blocks of layers the hare didn't finish")
+		}
+		// the input vector keeps the hare's result, the blocks the full tortoise found valid are saved apart
+		_, err = msh.GetLayerInputVector(l)
+		require.Equal(t, database.ErrNotFound, err)
+		healed, err := msh.GetLayerHealedVector(l)
+		require.NoError(t, err)
+		require.Empty(t, healed)
+	}
+	ids, err := msh.LayerBlockIds(types.GetEffectiveGenesis() + 5)
+	require.NoError(t, err)
+	for _, id := range ids {
+		valid, err := msh.ContextualValidity(id)
+		require.NoError(t, err)
+		require.True(t, valid)
+	}
+}
+
+type atxWeightsMock map[types.ATXID]uint64
+
+func (m atxWeightsMock) GetAtxHeader(id types.ATXID) (*types.ActivationTxHeader, error) {
+	w, ok := m[id]
+	if !ok {
+		return nil, errors.New("atx not found")
+	}
+	return &types.ActivationTxHeader{NIPSTChallenge: types.NIPSTChallenge{EndTick: 1}, Space: w}, nil
+}
+
+func (m atxWeightsMock) GetEpochWeight(types.EpochID) (uint64, []types.ATXID, error) {
+	var total uint64
+	var atxs []types.ATXID
+	for id, w := range m {
+		total += w
+		atxs = append(atxs, id)
+	}
+	return total, atxs, nil
+}
+
+// countingAtxDb counts the reads of every ATX.
+type countingAtxDb struct {
+	atxWeightsMock
+	calls map[types.ATXID]int
+}
+
+func (m *countingAtxDb) GetAtxHeader(id types.ATXID) (*types.ActivationTxHeader, error) {
+	m.calls[id]++
+	return m.atxWeightsMock.GetAtxHeader(id)
+}
+
+func TestTurtle_AtxWeightedVotes(t *testing.T) {
+	const blocksPerLayer = 10
+	heavy := types.ATXID(types.HexToHash32("11"))
+	light := types.ATXID(types.HexToHash32("22"))
+	unknown := types.ATXID(types.HexToHash32("33"))
+	last := types.GetEffectiveGenesis() + 10
+
+	msh := getInMemMesh()
+	trtl := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+	trtl.atxdb = atxWeightsMock{heavy: 30, light: 10}
+	trtl.init(mesh.GenesisLayer())
+	handle := func(l types.LayerID) {
+		b, lists, err := trtl.BaseBlock()
+		require.NoError(t, err)
+		if l > types.GetEffectiveGenesis()+1 {
+			base, err := msh.GetBlock(b)
+			require.NoError(t, err)
+			require.Equal(t, heavy, base.ATXID, "the heaviest block is chosen as the base block")
+		}
+		lyr := types.NewLayer(l)
+		// the light ATX made most of the blocks, and one block has no known ATX
+		for i := 0; i < blocksPerLayer+1; i++ {
+			blk := types.NewExistingBlock(l, []byte(strconv.Itoa(i)), nil)
+			switch i {
+			case 0:
+				blk.ATXID = heavy
+			case blocksPerLayer:
+				blk.ATXID = unknown
+			default:
+				blk.ATXID = light
+			}
+			blk.BaseBlock = b
+			blk.AgainstDiff = lists[0]
+			blk.ForDiff = lists[1]
+			blk.NeutralDiff = lists[2]
+			blk.Initialize()
+			lyr.AddBlock(blk)
+			require.NoError(t, msh.AddBlock(blk))
+		}
+		input := types.BlockIDs(lyr.Blocks())
+		require.NoError(t, msh.SaveLayerInputVector(l, input))
+		trtl.HandleIncomingLayer(lyr, input)
+	}
+	for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+		handle(l)
+	}
+	require.Equal(t, int(last-1), int(trtl.Verified))
+
+	blocks, err := msh.LayerBlocks(last)
+	require.NoError(t, err)
+	weights := make(map[types.ATXID]int)
+	var unknownBlock types.BlockID
+	for _, blk := range blocks {
+		weights[blk.ATXID] += trtl.voteWeight(last, blk.ID())
+		if blk.ATXID == unknown {
+			unknownBlock = blk.ID()
+		}
+	}
+	// the weights are relative to the average weight of the epoch's ATXs, and the nine blocks of the light ATX together
+	// weigh as much as their ATX, a third of the heavy ATX's single block (rounded down)
+	require.Equal(t, map[types.ATXID]int{heavy: 3 * weightUnit / 2, light: 9 * (weightUnit / 2 / 9), unknown: 0}, weights)
+	// only two ATXs made the blocks of the layer
+	require.Equal(t, 2*weightUnit, trtl.layerWeight(last))
+
+	// the weights of blocks whose ATX is missing aren't cached, they count once it arrives
+	trtl.atxdb = atxWeightsMock{heavy: 30, light: 10, unknown: 20}
+	trtl.epochWeights = nil
+	require.Equal(t, weightUnit, trtl.voteWeight(last, unknownBlock))
+
+	// the weights of the layers with a missing ATX are computed once while a layer is handled, and not kept after it
+	counting := &countingAtxDb{atxWeightsMock: atxWeightsMock{heavy: 30, light: 10}, calls: make(map[types.ATXID]int)}
+	trtl.atxdb = counting
+	trtl.epochWeights = nil
+	trtl.atxWeights = nil
+	trtl.blockWeights = nil
+	handle(last + 1)
+	require.NotZero(t, counting.calls[unknown])
+	require.LessOrEqual(t, counting.calls[unknown], int(trtl.Last-trtl.Evict+1))
+	require.Nil(t, trtl.incompleteWeights)
+
+	// weights that don't fit in an int are normalized without overflowing
+	huge := types.ATXID(types.HexToHash32("44"))
+	trtl.atxdb = atxWeightsMock{huge: math.MaxUint64 / 2, light: 1}
+	w, ok := trtl.normalizedAtxWeight(last.GetEpoch()+1, huge)
+	require.True(t, ok)
+	require.Equal(t, 2*weightUnit-1, w)
+}
+
+func TestTurtle_InspectLayer(t *testing.T) {
+	const blocksPerLayer = 10
+	last := types.GetEffectiveGenesis() + 6
+	failed := types.GetEffectiveGenesis() + 3
+
+	msh := getInMemMesh()
+	trtl := newTurtle(msh, defaultTestHdist, blocksPerLayer)
+	trtl.init(mesh.GenesisLayer())
+	for l := types.GetEffectiveGenesis() + 1; l <= last; l++ {
+		b, lists, err := trtl.BaseBlock()
+		require.NoError(t, err)
+		lyr := types.NewLayer(l)
+		for i := 0; i < blocksPerLayer; i++ {
+			blk := types.NewExistingBlock(l, []byte(strconv.Itoa(i)), nil)
+			blk.BaseBlock = b
+			blk.AgainstDiff = lists[0]
+			blk.ForDiff = lists[1]
+			blk.NeutralDiff = lists[2]
+			blk.Initialize()
+			lyr.AddBlock(blk)
+			require.NoError(t, msh.AddBlock(blk))
+		}
+		var input []types.BlockID
+		if l != failed {
+			input = types.BlockIDs(lyr.Blocks())
+			require.NoError(t, msh.SaveLayerInputVector(l, input))
+		}
+		trtl.HandleIncomingLayer(lyr, input)
+	}
+	require.Equal(t, int(failed-1), int(trtl.Verified))
+
+	inspection, err := trtl.inspectLayer(failed - 1)
+	require.NoError(t, err)
+	require.Empty(t, inspection.Reason)
+	require.Equal(t, last, inspection.Last)
+	require.Len(t, inspection.Blocks, blocksPerLayer)
+	for _, b := range inspection.Blocks {
+		require.Equal(t, "support", b.Input)
+		require.Equal(t, "support", b.GlobalOpinion)
+		require.Greater(t, float64(b.Tally.Support), inspection.Threshold)
+		// every block of the later layers votes on the block
+		require.Len(t, b.Votes, blocksPerLayer*int(last-failed+1))
+	}
+
+	inspection, err = trtl.inspectLayer(failed)
+	require.NoError(t, err)
+	for _, b := range inspection.Blocks {
+		require.Equal(t, "abstain", b.Input)
+	}
+	require.Contains(t, inspection.Reason, "don't reach the threshold")
+
+	inspection, err = trtl.inspectLayer(failed + 1)
+	require.NoError(t, err)
+	require.Contains(t, inspection.Reason, fmt.Sprintf("layer %v before it isn't verified", failed))
+
+	_, err = trtl.inspectLayer(last + 1)
+	require.Error(t, err)
+
+	// the weights computed for an inspection aren't cached
+	trtl.atxdb = atxWeightsMock{types.ATXID{}: 10}
+	_, err = trtl.inspectLayer(failed)
+	require.NoError(t, err)
+	require.Empty(t, trtl.epochWeights)
+	require.Empty(t, trtl.atxWeights)
+	require.Empty(t, trtl.blockWeights)
+}
@@ -56,6 +56,8 @@ type Database struct {
 	preimages map[types.Hash32][]byte // Preimages of nodes from the secure trie
 	seckeybuf [secureKeyLength]byte   // Ephemeral buffer for calculating preimage keys
 
+	dereferenced map[types.Hash32]struct{} // Persisted nodes that lost a reference since the last TakeDereferenced
+
 	gctime  time.Duration     // Time spent on garbage collection since last commit
 	gcnodes uint64            // Nodes garbage collected since last commit
 	gcsize  types.StorageSize // Data storage garbage collected since last commit
@@ -253,8 +255,9 @@ func expandNode(hash hashNode, n node, cachegen uint16) node {
 func NewDatabase(diskdb database.Database) *Database {
 	return &Database{
 		diskdb:    diskdb,
-		nodes:     map[types.Hash32]*cachedNode{{}: {}},
-		preimages: make(map[types.Hash32][]byte),
+		nodes:        map[types.Hash32]*cachedNode{{}: {}},
+		preimages:    make(map[types.Hash32][]byte),
+		dereferenced: make(map[types.Hash32]struct{}),
 	}
 }
 
@@ -375,6 +378,14 @@ func (db *Database) secureKey(key []byte) []byte {
 	return buf
 }
 
+// PreimageKey returns the key under which the preimage of a secure trie key is
+// stored in the disk database, given the hash of the key.
+func PreimageKey(hash types.Hash32) []byte {
+	key := make([]byte, 0, secureKeyLength)
+	key = append(key, secureKeyPrefix...)
+	return append(key, hash[:]...)
+}
+
 // Nodes retrieves the hashes of all the nodes cached within the memory database.
 // This method is extremely expensive and should only be used to validate internal
 // states in test code.
@@ -455,9 +466,11 @@ func (db *Database) dereference(child types.Hash32, parent types.Hash32) {
 			delete(node.children, child)
 		}
 	}
-	// If the child does not exist, it's a previously committed node.
+	// If the child does not exist, it's a previously committed node. Keep track of
+	// it, since it may no longer be used by any trie.
 	node, ok := db.nodes[child]
 	if !ok {
+		db.dereferenced[child] = struct{}{}
 		return
 	}
 	// If there are no more references to the child, delete it and cascade
@@ -490,6 +503,22 @@ func (db *Database) dereference(child types.Hash32, parent types.Hash32) {
 	}
 }
 
+// TakeDereferenced returns the persisted nodes that lost a reference from a
+// garbage collected node, or a dereferenced root, since the last call. They're
+// the roots of the persisted subtries that may no longer be used by any trie,
+// but other tries, persisted or in memory, may still use them.
+func (db *Database) TakeDereferenced() []types.Hash32 {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	nodes := make([]types.Hash32, 0, len(db.dereferenced))
+	for hash := range db.dereferenced {
+		nodes = append(nodes, hash)
+	}
+	db.dereferenced = make(map[types.Hash32]struct{})
+	return nodes
+}
+
 // Cap iteratively flushes old but still referenced trie nodes until the total
 // memory usage goes below the given threshold.
 func (db *Database) Cap(limit types.StorageSize) error {